@@ -4,18 +4,24 @@ import (
 	"context"
 	"reflect"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/flowcontrol"
 
 	"github.com/argoproj/argo-cd/controller/metrics"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/diff"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/settings"
 )
@@ -24,6 +30,7 @@ type cacheSettings struct {
 	ResourceOverrides   map[string]appv1.ResourceOverride
 	AppInstanceLabelKey string
 	ResourcesFilter     *settings.ResourcesFilter
+	ResourceCache       *settings.ResourceCacheSettings
 }
 
 type LiveStateCache interface {
@@ -31,21 +38,142 @@ type LiveStateCache interface {
 	GetServerVersion(serverURL string) (string, error)
 	// Returns true of given group kind is a namespaced resource
 	IsNamespaced(server string, gk schema.GroupKind) (bool, error)
+	// Same as IsNamespaced but also returns false if the given kind is unknown to the cache, instead of assuming that
+	// unknown kinds are namespaced
+	IsNamespacedKnown(server string, gk schema.GroupKind) (namespaced bool, known bool, err error)
+	// Returns true if the watch of the given kind is established and has not failed recently
+	IsWatchHealthy(server string, gk schema.GroupKind) (bool, error)
 	// Executes give callback against resource specified by the key and all its children
 	IterateHierarchy(server string, key kube.ResourceKey, action func(child appv1.ResourceNode, appName string)) error
+	// Executes given callback against resources specified by the keys and all their children. Resource owned by several
+	// parents is visited once; children of the resource are skipped if the callback returns false.
+	IterateHierarchyV2(server string, keys []kube.ResourceKey, action func(child appv1.ResourceNode, appName string) bool) error
+	// Returns a point-in-time copy of the specified resource and all its children
+	SnapshotHierarchy(server string, key kube.ResourceKey) ([]appv1.ResourceNode, error)
 	// Returns state of live nodes which correspond for target nodes of specified application.
 	GetManagedLiveObjs(a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error)
+	// Same as GetManagedLiveObjs but stops waiting for resources loaded from the cluster and returns the context error as
+	// soon as the given context is done
+	GetManagedLiveObjsContext(ctx context.Context, a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error)
+	// Same as GetManagedLiveObjs but returns copies of live objects without server populated fields (status, managed fields
+	// etc) and normalized using the given normalizer. Returned objects are suitable for diffing only.
+	GetNormalizedManagedLiveObjs(a *appv1.Application, targetObjs []*unstructured.Unstructured, normalizer diff.Normalizer) (map[kube.ResourceKey]*unstructured.Unstructured, error)
+	// Returns patches which reconcile live state of specified application with the target objects. Only live objects
+	// which differ from the corresponding target objects are included.
+	ComputeLiveTargetPatches(a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey][]byte, error)
+	// Returns sorted names of the namespaces of the specified cluster which have cached resources
+	GetNamespaces(server string) ([]string, error)
 	// Returns all top level resources (resources without owner references) of a specified namespace
 	GetNamespaceTopLevelResources(server string, namespace string) (map[kube.ResourceKey]appv1.ResourceNode, error)
+	// Returns resources of the specified namespace which have owner references but none of the owners is cached
+	GetDanglingResources(server string, namespace string) ([]appv1.ResourceNode, error)
 	// Starts watching resources of each controlled cluster.
 	Run(ctx context.Context) error
 	// Invalidate invalidates the entire cluster state cache
 	Invalidate()
 	// Returns information about monitored clusters
 	GetClustersInfo() []metrics.ClusterInfo
+	// Returns the resolved watch configuration of the specified cluster
+	DumpWatchConfig(server string) (WatchConfig, error)
+	// Returns a copy of the cached state of every resource of the specified cluster
+	DumpResources(server string) (map[kube.ResourceKey]ResourceSnapshot, error)
+	// Returns keys of resources changed since the given token and the token to retrieve the next changes
+	ChangesSince(server string, token string) ([]kube.ResourceKey, string, error)
+	// Returns the number which is incremented on every change of the cached resources of the specified cluster
+	GetCacheGeneration(server string) (uint64, error)
+	// Returns resources which cached manifest matches the given predicate. Resources without cached manifest are skipped.
+	QueryResources(server string, predicate func(un *unstructured.Unstructured) bool) ([]appv1.ResourceNode, error)
+	// Returns resources of the specified namespace, or of the whole cluster if the namespace is empty, which labels match
+	// the given selector. Labels are matched even if the resource manifest is not cached.
+	GetResourcesByLabel(server string, selector labels.Selector, namespace string) ([]appv1.ResourceNode, error)
+	// Returns direct children of the specified resource which are controlled by it
+	GetControllerChildren(server string, key kube.ResourceKey) ([]appv1.ResourceNode, error)
+	// Returns the cached resource with the specified key and false if the resource is not cached
+	GetResource(server string, key kube.ResourceKey) (*appv1.ResourceNode, bool, error)
+	// Returns the time it took to process the latest watch event of the specified cluster
+	GetEventProcessingLag(server string) (time.Duration, error)
+	// Returns errors of kinds which failed to sync during the latest sync of the specified cluster
+	GetSyncErrors(server string) (map[schema.GroupKind]error, error)
+	// Returns the estimated number of bytes received by the watches of each kind of the specified cluster
+	GetWatchBytesReceived(server string) (map[schema.GroupKind]int64, error)
+	// Returns the number of watch events received for each kind of the specified cluster. Stats are reset if requested.
+	GetEventStats(server string, reset bool) (map[schema.GroupKind]EventStats, error)
+	// Returns resources of the specified namespace sorted using the given comparator
+	GetSortedResources(server string, namespace string, less func(a, b *appv1.ResourceNode) bool) ([]appv1.ResourceNode, error)
+	// Returns the latest API server warning, such as API deprecation warning, for each kind of the specified cluster
+	GetDeprecationWarnings(server string) (map[schema.GroupKind]string, error)
+	// Returns kinds of the specified cluster which are watched but have no cached resources
+	GetEmptyWatchedKinds(server string) ([]schema.GroupKind, error)
+	// Returns keys of the resources of the specified cluster which have not been listed, updated or confirmed by the watch
+	// bookmark within the given period
+	GetStaleResources(server string, olderThan time.Duration) ([]kube.ResourceKey, error)
+	// Returns keys of the resources of the specified cluster which manifests are not cached because of the size limit
+	GetOversizedResources(server string) ([]kube.ResourceKey, error)
+	// Returns the number of cached resources of each kind of the specified cluster
+	GetResourceCountByGroupKind(server string) (map[schema.GroupKind]int, error)
+	// Returns the number of cached resources of each kind grouped by namespace of the specified cluster
+	GetInventoryMatrix(server string) (map[string]map[schema.GroupKind]int, error)
+	// Evicts resources of the given namespace of the specified cluster so they are re-listed without full cache invalidation
+	InvalidateNamespace(server string, namespace string) error
+	// Returns the state of caching of every kind of the specified cluster
+	GetKindStatuses(server string) (map[schema.GroupKind]KindStatus, error)
+	// Returns the state of the sync of the specified cluster without triggering the sync
+	GetSyncStatus(server string) (SyncStatus, error)
+	// Stops watching the given kind of the specified cluster and removes its resources until the kind is unevicted
+	EvictKind(server string, gk schema.GroupKind) error
+	// Resumes caching of the previously evicted kind of the specified cluster
+	UnevictKind(server string, gk schema.GroupKind) error
+	// Re-lists resources of the given kind of the specified cluster without full cache invalidation
+	RefreshKind(server string, gk schema.GroupKind) error
+	// Returns the channel which receives all changes of the cached resources of the specified cluster and the function
+	// which cancels the subscription. Events are delivered without blocking the cache: if the consumer doesn't keep up,
+	// events are dropped according to the given policy, DropNewestEvent if the policy is empty.
+	Events(server string, buffer int, policy EventsOverflowPolicy) (<-chan ResourceEvent, func(), error)
+	// Returns the total number of events dropped because subscribers of the specified cluster did not keep up with the
+	// events rate, including events dropped by subscribers which have unsubscribed since then
+	GetDroppedEvents(server string) (int, error)
+	// Changes the set of cached namespaces of the specified cluster without full cache invalidation
+	UpdateNamespaces(server string, namespaces []string) error
 }
 
-type ObjectUpdatedHandler = func(managedByApp map[string]bool, ref v1.ObjectReference)
+// ObjectUpdatedHandler is notified about added, modified or deleted object. The event type allows distinguishing creation from update.
+type ObjectUpdatedHandler = func(managedByApp map[string]bool, ref v1.ObjectReference, event watch.EventType)
+
+// ObjectsRemovedHandler is notified about all objects removed by a single relist of the kind
+type ObjectsRemovedHandler = func(refs []v1.ObjectReference)
+
+// ObjectRemovedHandler is notified about deleted object
+type ObjectRemovedHandler = func(managedByApp map[string]bool, ref v1.ObjectReference)
+
+// Discoverer retrieves the server version and the API resources of the cluster. It allows replacing the kubectl based
+// discovery, e.g. with canned results in tests.
+type Discoverer interface {
+	GetServerVersion(config *rest.Config) (string, error)
+	GetAPIResources(config *rest.Config, resourceFilter kube.ResourceFilter) ([]kube.APIResourceInfo, error)
+}
+
+// ParentResolvedHandler is notified when the owner of a cached resource is added to the cache after the resource itself
+type ParentResolvedHandler = func(parent v1.ObjectReference, child v1.ObjectReference)
+
+// WatchMetricsRecorder is notified about watch reconnects and list requests durations, so that flapping watches can be detected
+type WatchMetricsRecorder interface {
+	OnWatchReconnect(gk schema.GroupKind)
+	OnListDuration(gk schema.GroupKind, duration time.Duration)
+}
+
+// clusterMetricsRecorder reports watch metrics of the cluster to the metrics server
+type clusterMetricsRecorder struct {
+	server        string
+	metricsServer *metrics.MetricsServer
+}
+
+func (r *clusterMetricsRecorder) OnWatchReconnect(gk schema.GroupKind) {
+	r.metricsServer.IncWatchReconnect(r.server, gk)
+}
+
+func (r *clusterMetricsRecorder) OnListDuration(gk schema.GroupKind, duration time.Duration) {
+	r.metricsServer.ObserveListDuration(r.server, gk, duration)
+}
 
 func GetTargetObjKey(a *appv1.Application, un *unstructured.Unstructured, isNamespaced bool) kube.ResourceKey {
 	key := kube.GetResourceKey(un)
@@ -105,7 +233,128 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &cacheSettings{AppInstanceLabelKey: appInstanceLabelKey, ResourceOverrides: resourceOverrides, ResourcesFilter: resourcesFilter}, nil
+	resourceCache, err := c.settingsMgr.GetResourceCacheSettings()
+	if err != nil {
+		return nil, err
+	}
+	return &cacheSettings{AppInstanceLabelKey: appInstanceLabelKey, ResourceOverrides: resourceOverrides, ResourcesFilter: resourcesFilter, ResourceCache: resourceCache}, nil
+}
+
+// applyResourceCacheSettings configures the cluster cache according to the resource cache settings
+func applyResourceCacheSettings(info *clusterInfo, cacheSettings *cacheSettings) {
+	if cacheSettings == nil || cacheSettings.ResourceCache == nil {
+		return
+	}
+	resourceCache := cacheSettings.ResourceCache
+	info.skipNoOpUpdates = resourceCache.SkipNoOpUpdates
+	info.preferCachedNodes = resourceCache.PreferCachedNodes
+	info.preserveNodeInfo = resourceCache.PreserveNodeInfo
+	info.resyncPeriodOverrides = make(map[schema.GroupKind]time.Duration)
+	for _, resync := range resourceCache.ResyncPeriods {
+		info.resyncPeriodOverrides[schema.GroupKind{Group: resync.Group, Kind: resync.Kind}] = resync.Period.Duration
+	}
+	info.trimObjectMeta = resourceCache.TrimObjectMeta
+	info.controllerOwnerRefsOnly = resourceCache.ControllerOwnerRefsOnly
+	info.listPageSize = resourceCache.ListPageSize
+	info.trackWatchBytes = resourceCache.TrackWatchBytes
+	// the selector is validated when the settings are loaded
+	info.resourceLabelSelector, _ = labels.Parse(resourceCache.LabelSelector)
+	info.watchRetryBackoff = nil
+	if backoff := resourceCache.WatchRetryBackoff; backoff != nil {
+		// zero delay would make failing watches retry in a hot loop
+		duration := backoff.Duration.Duration
+		if duration <= 0 {
+			duration = watchResourcesRetryTimeout
+		}
+		factor := backoff.Factor
+		if factor <= 0 {
+			factor = 1
+		}
+		info.watchRetryBackoff = &wait.Backoff{Duration: duration, Factor: factor, Jitter: backoff.Jitter, Cap: backoff.Cap.Duration}
+	}
+	fieldSelectors := make(map[schema.GroupKind]string)
+	for _, selector := range resourceCache.FieldSelectors {
+		fieldSelectors[schema.GroupKind{Group: selector.Group, Kind: selector.Kind}] = selector.Selector
+	}
+	info.setFieldSelectors(fieldSelectors)
+	info.watchBookmarks = resourceCache.WatchBookmarks
+	if info.namespaceWatchConcurrency != resourceCache.NamespaceWatchConcurrency {
+		info.namespaceWatchConcurrency = resourceCache.NamespaceWatchConcurrency
+		// the semaphore is re-created with the new limit by the next sync
+		info.namespaceWatchSlots = nil
+	}
+	info.watchEstablishRateLimiter = nil
+	if resourceCache.WatchEstablishQPS > 0 {
+		burst := resourceCache.WatchEstablishBurst
+		if burst <= 0 {
+			burst = 1
+		}
+		info.watchEstablishRateLimiter = flowcontrol.NewTokenBucketRateLimiter(resourceCache.WatchEstablishQPS, burst)
+	}
+	info.syncTimeout = resourceCache.SyncTimeout.Duration
+	info.retryTimeout = resourceCache.RetryTimeout.Duration
+	info.listConfigTweak = nil
+	if qps, burst := resourceCache.ListQPS, resourceCache.ListBurst; qps > 0 || burst > 0 {
+		info.listConfigTweak = func(config *rest.Config) {
+			if qps > 0 {
+				config.QPS = qps
+			}
+			if burst > 0 {
+				config.Burst = burst
+			}
+		}
+	}
+	info.watchConfigTweak = nil
+	if timeout := resourceCache.WatchTimeout.Duration; timeout > 0 {
+		info.watchConfigTweak = func(config *rest.Config) {
+			config.Timeout = timeout
+		}
+	}
+	info.debugLocking = resourceCache.DebugLocking
+	info.setMaxConcurrentLiveQueries(resourceCache.MaxConcurrentLiveQueries)
+	info.maxObjectSize = resourceCache.MaxObjectSize
+	info.namespaceResourceFilter = nil
+	if exclusions := resourceCache.NamespaceExclusions; len(exclusions) > 0 {
+		server := info.cluster.Server
+		info.namespaceResourceFilter = func(ns string, gk schema.GroupKind) bool {
+			for _, exclusion := range exclusions {
+				if exclusion.Match(gk.Group, gk.Kind, server, ns) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	info.priorityKinds = toGroupKinds(resourceCache.PriorityKinds)
+	info.maxResources = resourceCache.MaxResources
+	info.skipEndpointsOwnership = resourceCache.SkipEndpointsOwnership
+	info.skipServiceAccountTokenOwnership = resourceCache.SkipServiceAccountTokenOwnership
+	info.maxSyncRetries = resourceCache.MaxSyncRetries
+	info.includeClusterScopedKinds = toGroupKinds(resourceCache.IncludeClusterScopedKinds)
+	info.lazyResourceInfo = resourceCache.LazyResourceInfo
+	info.eventCoalesceWindow = resourceCache.EventCoalesceWindow.Duration
+	info.groupKindNormalizer = nil
+	if aliases := resourceCache.GroupKindAliases; len(aliases) > 0 {
+		canonical := make(map[schema.GroupKind]schema.GroupKind)
+		for _, alias := range aliases {
+			canonical[schema.GroupKind{Group: alias.Group, Kind: alias.Kind}] = schema.GroupKind{Group: alias.CanonicalGroup, Kind: alias.Kind}
+		}
+		info.groupKindNormalizer = func(gk schema.GroupKind) schema.GroupKind {
+			if res, ok := canonical[gk]; ok {
+				return res
+			}
+			return gk
+		}
+	}
+}
+
+// toGroupKinds converts the kinds of the resource cache settings to group kinds
+func toGroupKinds(kinds []settings.CachedKind) []schema.GroupKind {
+	res := make([]schema.GroupKind, len(kinds))
+	for i := range kinds {
+		res[i] = schema.GroupKind{Group: kinds[i].Group, Kind: kinds[i].Kind}
+	}
+	return res
 }
 
 func (c *liveStateCache) getCluster(server string) (*clusterInfo, error) {
@@ -119,7 +368,7 @@ func (c *liveStateCache) getCluster(server string) (*clusterInfo, error) {
 		}
 		info = &clusterInfo{
 			apisMeta:         make(map[schema.GroupKind]*apiMeta),
-			lock:             &sync.Mutex{},
+			lock:             &sync.RWMutex{},
 			nodes:            make(map[kube.ResourceKey]*node),
 			nsIndex:          make(map[string]map[kube.ResourceKey]*node),
 			onObjectUpdated:  c.onObjectUpdated,
@@ -131,10 +380,26 @@ func (c *liveStateCache) getCluster(server string) (*clusterInfo, error) {
 			onEventReceived: func(event watch.EventType, un *unstructured.Unstructured) {
 				c.metricsServer.IncClusterEventsCount(cluster.Server)
 			},
+			metricsRecorder: &clusterMetricsRecorder{server: cluster.Server, metricsServer: c.metricsServer},
+		}
+		info.onWatchStopped = func(gk schema.GroupKind, reason string) {
+			c.metricsServer.IncWatchStop(cluster.Server, gk, reason)
+		}
+		info.onCacheOverLimit = func(count int, limit int) {
+			c.metricsServer.IncCacheOverLimit(cluster.Server)
 		}
+		info.onSyncStateChanged = func(nowHealthy bool, err error) {
+			if nowHealthy {
+				info.log.Info("Cluster cache sync recovered")
+			} else {
+				info.log.Warnf("Cluster cache sync started failing: %v", err)
+			}
+		}
+		applyResourceCacheSettings(info, c.getCacheSettings())
 
 		c.clusters[cluster.Server] = info
 	}
+	info.checkHandlerReentrance()
 	return info, nil
 }
 
@@ -150,12 +415,40 @@ func (c *liveStateCache) getSyncedCluster(server string) (*clusterInfo, error) {
 	return info, nil
 }
 
+// getClusterSyncedForKinds returns the cluster cache once resources of the given kinds are available, which happens
+// before the whole cluster is synced if every kind is a priority kind
+func (c *liveStateCache) getClusterSyncedForKinds(ctx context.Context, server string, gks []schema.GroupKind) (*clusterInfo, error) {
+	info, err := c.getCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	err = info.ensureKindsSynced(ctx, gks)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// targetKinds returns kinds of the given target objects
+func targetKinds(targetObjs []*unstructured.Unstructured) []schema.GroupKind {
+	gks := make([]schema.GroupKind, 0, len(targetObjs))
+	for _, obj := range targetObjs {
+		if obj != nil {
+			gks = append(gks, obj.GroupVersionKind().GroupKind())
+		}
+	}
+	return gks
+}
+
 func (c *liveStateCache) Invalidate() {
 	log.Info("invalidating live state cache")
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	cacheSettings := c.getCacheSettings()
 	for _, clust := range c.clusters {
-		clust.invalidate()
+		clust.invalidate(func(info *clusterInfo) {
+			applyResourceCacheSettings(info, cacheSettings)
+		})
 	}
 	log.Info("live state cache invalidated")
 }
@@ -168,8 +461,25 @@ func (c *liveStateCache) IsNamespaced(server string, gk schema.GroupKind) (bool,
 	return clusterInfo.isNamespaced(gk), nil
 }
 
-func (c *liveStateCache) IterateHierarchy(server string, key kube.ResourceKey, action func(child appv1.ResourceNode, appName string)) error {
+func (c *liveStateCache) IsNamespacedKnown(server string, gk schema.GroupKind) (bool, bool, error) {
 	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return false, false, err
+	}
+	namespaced, known := clusterInfo.isNamespacedKnown(gk)
+	return namespaced, known, nil
+}
+
+func (c *liveStateCache) IsWatchHealthy(server string, gk schema.GroupKind) (bool, error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return false, err
+	}
+	return clusterInfo.isWatchHealthy(gk), nil
+}
+
+func (c *liveStateCache) IterateHierarchy(server string, key kube.ResourceKey, action func(child appv1.ResourceNode, appName string)) error {
+	clusterInfo, err := c.getClusterSyncedForKinds(context.Background(), server, []schema.GroupKind{key.GroupKind()})
 	if err != nil {
 		return err
 	}
@@ -177,6 +487,35 @@ func (c *liveStateCache) IterateHierarchy(server string, key kube.ResourceKey, a
 	return nil
 }
 
+func (c *liveStateCache) IterateHierarchyV2(server string, keys []kube.ResourceKey, action func(child appv1.ResourceNode, appName string) bool) error {
+	gks := make([]schema.GroupKind, 0, len(keys))
+	for _, key := range keys {
+		gks = append(gks, key.GroupKind())
+	}
+	clusterInfo, err := c.getClusterSyncedForKinds(context.Background(), server, gks)
+	if err != nil {
+		return err
+	}
+	clusterInfo.iterateHierarchyV2(keys, action)
+	return nil
+}
+
+func (c *liveStateCache) SnapshotHierarchy(server string, key kube.ResourceKey) ([]appv1.ResourceNode, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.snapshotHierarchy(key), nil
+}
+
+func (c *liveStateCache) GetNamespaces(server string) ([]string, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getNamespaces(), nil
+}
+
 func (c *liveStateCache) GetNamespaceTopLevelResources(server string, namespace string) (map[kube.ResourceKey]appv1.ResourceNode, error) {
 	clusterInfo, err := c.getSyncedCluster(server)
 	if err != nil {
@@ -185,13 +524,55 @@ func (c *liveStateCache) GetNamespaceTopLevelResources(server string, namespace
 	return clusterInfo.getNamespaceTopLevelResources(namespace), nil
 }
 
+func (c *liveStateCache) GetDanglingResources(server string, namespace string) ([]appv1.ResourceNode, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getDanglingResources(namespace), nil
+}
+
 func (c *liveStateCache) GetManagedLiveObjs(a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
-	clusterInfo, err := c.getSyncedCluster(a.Spec.Destination.Server)
+	clusterInfo, err := c.getClusterSyncedForKinds(context.Background(), a.Spec.Destination.Server, targetKinds(targetObjs))
 	if err != nil {
 		return nil, err
 	}
 	return clusterInfo.getManagedLiveObjs(a, targetObjs, c.metricsServer)
 }
+
+func (c *liveStateCache) GetManagedLiveObjsContext(ctx context.Context, a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	clusterInfo, err := c.getClusterSyncedForKinds(ctx, a.Spec.Destination.Server, targetKinds(targetObjs))
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getManagedLiveObjsContext(ctx, a, targetObjs, c.metricsServer)
+}
+
+func (c *liveStateCache) GetNormalizedManagedLiveObjs(a *appv1.Application, targetObjs []*unstructured.Unstructured, normalizer diff.Normalizer) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	liveObjs, err := c.GetManagedLiveObjs(a, targetObjs)
+	if err != nil {
+		return nil, err
+	}
+	for key, liveObj := range liveObjs {
+		if liveObj != nil {
+			liveObjs[key] = normalizeLiveObj(liveObj, normalizer)
+		}
+	}
+	return liveObjs, nil
+}
+
+func (c *liveStateCache) ComputeLiveTargetPatches(a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey][]byte, error) {
+	clusterInfo, err := c.getSyncedCluster(a.Spec.Destination.Server)
+	if err != nil {
+		return nil, err
+	}
+	liveObjs, err := clusterInfo.getManagedLiveObjs(a, targetObjs, c.metricsServer)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.computeLiveTargetPatches(a, targetObjs, liveObjs)
+}
+
 func (c *liveStateCache) GetServerVersion(serverURL string) (string, error) {
 	clusterInfo, err := c.getSyncedCluster(serverURL)
 	if err != nil {
@@ -264,7 +645,7 @@ func (c *liveStateCache) Run(ctx context.Context) error {
 			defer c.lock.Unlock()
 			if cluster, ok := c.clusters[event.Cluster.Server]; ok {
 				if event.Type == watch.Deleted {
-					cluster.invalidate()
+					cluster.stop()
 					delete(c.clusters, event.Cluster.Server)
 				} else if event.Type == watch.Modified {
 					cluster.cluster = event.Cluster
@@ -295,3 +676,229 @@ func (c *liveStateCache) GetClustersInfo() []metrics.ClusterInfo {
 	}
 	return res
 }
+
+func (c *liveStateCache) DumpWatchConfig(server string) (WatchConfig, error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return WatchConfig{}, err
+	}
+	return clusterInfo.dumpWatchConfig(), nil
+}
+
+func (c *liveStateCache) DumpResources(server string) (map[kube.ResourceKey]ResourceSnapshot, error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.dumpResources(), nil
+}
+
+func (c *liveStateCache) GetEventProcessingLag(server string) (time.Duration, error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return 0, err
+	}
+	return clusterInfo.getEventProcessingLag(), nil
+}
+
+func (c *liveStateCache) GetSyncErrors(server string) (map[schema.GroupKind]error, error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getSyncErrors(), nil
+}
+
+func (c *liveStateCache) GetWatchBytesReceived(server string) (map[schema.GroupKind]int64, error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getWatchBytesReceived(), nil
+}
+
+func (c *liveStateCache) GetEventStats(server string, reset bool) (map[schema.GroupKind]EventStats, error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getEventStats(reset), nil
+}
+
+func (c *liveStateCache) GetInventoryMatrix(server string) (map[string]map[schema.GroupKind]int, error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getInventoryMatrix(), nil
+}
+
+func (c *liveStateCache) GetEmptyWatchedKinds(server string) ([]schema.GroupKind, error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getEmptyWatchedKinds(), nil
+}
+
+func (c *liveStateCache) GetStaleResources(server string, olderThan time.Duration) ([]kube.ResourceKey, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getStaleResources(olderThan), nil
+}
+
+func (c *liveStateCache) GetOversizedResources(server string) ([]kube.ResourceKey, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getOversizedResources(), nil
+}
+
+func (c *liveStateCache) GetCacheGeneration(server string) (uint64, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return 0, err
+	}
+	return clusterInfo.getCacheGeneration(), nil
+}
+
+func (c *liveStateCache) ChangesSince(server string, token string) ([]kube.ResourceKey, string, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, "", err
+	}
+	return clusterInfo.changesSince(token)
+}
+
+func (c *liveStateCache) QueryResources(server string, predicate func(un *unstructured.Unstructured) bool) ([]appv1.ResourceNode, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.queryResources(predicate), nil
+}
+
+func (c *liveStateCache) GetResourcesByLabel(server string, selector labels.Selector, namespace string) ([]appv1.ResourceNode, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getResourcesByLabel(selector, namespace), nil
+}
+
+func (c *liveStateCache) GetDeprecationWarnings(server string) (map[schema.GroupKind]string, error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getDeprecationWarnings(), nil
+}
+
+func (c *liveStateCache) GetResourceCountByGroupKind(server string) (map[schema.GroupKind]int, error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getResourceCountByGroupKind(), nil
+}
+
+func (c *liveStateCache) GetSortedResources(server string, namespace string, less func(a, b *appv1.ResourceNode) bool) ([]appv1.ResourceNode, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getSortedResources(namespace, less), nil
+}
+
+func (c *liveStateCache) GetControllerChildren(server string, key kube.ResourceKey) ([]appv1.ResourceNode, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getControllerChildren(key), nil
+}
+
+func (c *liveStateCache) GetResource(server string, key kube.ResourceKey) (*appv1.ResourceNode, bool, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, false, err
+	}
+	res, ok := clusterInfo.getResource(key)
+	return res, ok, nil
+}
+
+func (c *liveStateCache) InvalidateNamespace(server string, namespace string) error {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return err
+	}
+	return clusterInfo.invalidateNamespace(namespace)
+}
+
+func (c *liveStateCache) GetKindStatuses(server string) (map[schema.GroupKind]KindStatus, error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getKindStatuses(), nil
+}
+
+func (c *liveStateCache) GetSyncStatus(server string) (SyncStatus, error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return "", err
+	}
+	return clusterInfo.getSyncStatus(), nil
+}
+
+func (c *liveStateCache) Events(server string, buffer int, policy EventsOverflowPolicy) (<-chan ResourceEvent, func(), error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return nil, nil, err
+	}
+	events, unsubscribe := clusterInfo.subscribeEvents(buffer, policy)
+	return events, unsubscribe, nil
+}
+
+func (c *liveStateCache) EvictKind(server string, gk schema.GroupKind) error {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return err
+	}
+	return clusterInfo.evictKind(gk)
+}
+
+func (c *liveStateCache) RefreshKind(server string, gk schema.GroupKind) error {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return err
+	}
+	return clusterInfo.refreshKind(gk)
+}
+
+func (c *liveStateCache) GetDroppedEvents(server string) (int, error) {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return 0, err
+	}
+	return clusterInfo.droppedEvents(), nil
+}
+
+func (c *liveStateCache) UnevictKind(server string, gk schema.GroupKind) error {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return err
+	}
+	return clusterInfo.unevictKind(gk)
+}
+
+func (c *liveStateCache) UpdateNamespaces(server string, namespaces []string) error {
+	clusterInfo, err := c.getCluster(server)
+	if err != nil {
+		return err
+	}
+	return clusterInfo.updateNamespaces(namespaces)
+}
@@ -1,11 +1,28 @@
 package cache
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/controller/metrics"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+	appinformer "github.com/argoproj/argo-cd/pkg/client/informers/externalversions"
+	dbmocks "github.com/argoproj/argo-cd/util/db/mocks"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 func TestGetServerVersion(t *testing.T) {
@@ -15,7 +32,7 @@ func TestGetServerVersion(t *testing.T) {
 		clusters: map[string]*clusterInfo{
 			"http://localhost": {
 				syncTime:      &now,
-				lock:          &sync.Mutex{},
+				lock:          &sync.RWMutex{},
 				serverVersion: "123",
 			},
 		}}
@@ -24,3 +41,147 @@ func TestGetServerVersion(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "123", version)
 }
+
+func TestDebugLockingPanicsOnHandlerReentrance(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	cluster.debugLocking = true
+	cache := &liveStateCache{
+		lock:     &sync.Mutex{},
+		clusters: map[string]*clusterInfo{cluster.cluster.Server: cluster},
+	}
+	err := cluster.ensureSynced()
+	assert.NoError(t, err)
+
+	cluster.onObjectUpdated = func(managedByApp map[string]bool, ref v1.ObjectReference, event watch.EventType) {
+		_, _ = cache.GetNamespaceTopLevelResources(cluster.cluster.Server, "default")
+	}
+	assert.PanicsWithValue(t, handlerReentranceError{handler: "ObjectUpdated", server: cluster.cluster.Server}, func() {
+		cluster.processEvent(watch.Modified, testPod)
+	})
+
+	// cache is still usable after the panic and handlers which don't access the cache keep working
+	called := false
+	cluster.onObjectUpdated = func(managedByApp map[string]bool, ref v1.ObjectReference, event watch.EventType) {
+		called = true
+	}
+	cluster.processEvent(watch.Modified, testPod)
+	assert.True(t, called)
+	_, err = cache.GetNamespaceTopLevelResources(cluster.cluster.Server, "default")
+	assert.NoError(t, err)
+}
+
+func TestInvalidateAppliesResourceCacheSettings(t *testing.T) {
+	cluster := newCluster(testPod)
+	cache := &liveStateCache{
+		lock:              &sync.Mutex{},
+		clusters:          map[string]*clusterInfo{cluster.cluster.Server: cluster},
+		cacheSettingsLock: &sync.Mutex{},
+		cacheSettings: &cacheSettings{ResourceCache: &settings.ResourceCacheSettings{
+			SkipNoOpUpdates:                  true,
+			PreferCachedNodes:                true,
+			PreserveNodeInfo:                 true,
+			ResyncPeriods:                    []settings.KindResyncPeriod{{Group: "apps", Kind: "ReplicaSet", Period: metav1.Duration{Duration: time.Minute}}},
+			TrimObjectMeta:                   true,
+			ControllerOwnerRefsOnly:          true,
+			ListPageSize:                     10,
+			TrackWatchBytes:                  true,
+			LabelSelector:                    "app=guestbook",
+			WatchRetryBackoff:                &settings.WatchRetryBackoff{Duration: metav1.Duration{Duration: time.Second}, Factor: 2, Cap: metav1.Duration{Duration: time.Minute}},
+			FieldSelectors:                   []settings.KindFieldSelector{{Kind: "Pod", Selector: "status.phase!=Succeeded"}},
+			WatchBookmarks:                   true,
+			NamespaceWatchConcurrency:        2,
+			WatchEstablishQPS:                10,
+			SyncTimeout:                      metav1.Duration{Duration: time.Hour},
+			RetryTimeout:                     metav1.Duration{Duration: time.Minute},
+			ListQPS:                          50,
+			WatchTimeout:                     metav1.Duration{Duration: time.Minute},
+			DebugLocking:                     true,
+			MaxConcurrentLiveQueries:         5,
+			MaxObjectSize:                    1024,
+			NamespaceExclusions:              []settings.NamespaceResourceExclusion{{FilteredResource: settings.FilteredResource{Kinds: []string{"Event"}}, Namespaces: []string{"noisy"}}},
+			PriorityKinds:                    []settings.CachedKind{{Group: "apps", Kind: "Deployment"}},
+			MaxResources:                     100,
+			SkipEndpointsOwnership:           true,
+			SkipServiceAccountTokenOwnership: true,
+			MaxSyncRetries:                   3,
+			IncludeClusterScopedKinds:        []settings.CachedKind{{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}},
+			LazyResourceInfo:                 true,
+			GroupKindAliases:                 []settings.GroupKindAlias{{Group: "extensions", Kind: "ReplicaSet", CanonicalGroup: "apps"}},
+			EventCoalesceWindow:              metav1.Duration{Duration: time.Second},
+		}},
+	}
+	cache.Invalidate()
+
+	assert.True(t, cluster.skipNoOpUpdates)
+	assert.True(t, cluster.preferCachedNodes)
+	assert.True(t, cluster.preserveNodeInfo)
+	assert.Equal(t, map[schema.GroupKind]time.Duration{{Group: "apps", Kind: "ReplicaSet"}: time.Minute}, cluster.resyncPeriodOverrides)
+	assert.True(t, cluster.trimObjectMeta)
+	assert.True(t, cluster.controllerOwnerRefsOnly)
+	assert.Equal(t, int64(10), cluster.listPageSize)
+	assert.True(t, cluster.trackWatchBytes)
+	assert.Equal(t, "app=guestbook", cluster.resourceLabelSelector.String())
+	assert.Equal(t, &wait.Backoff{Duration: time.Second, Factor: 2, Cap: time.Minute}, cluster.watchRetryBackoff)
+	assert.Equal(t, "status.phase!=Succeeded", cluster.fieldSelector(schema.GroupKind{Kind: "Pod"}))
+	assert.True(t, cluster.watchBookmarks)
+	assert.Equal(t, 2, cluster.namespaceWatchConcurrency)
+	assert.NotNil(t, cluster.watchEstablishRateLimiter)
+	assert.Equal(t, time.Hour, cluster.syncTimeout)
+	assert.Equal(t, time.Minute, cluster.retryTimeout)
+	config := &rest.Config{QPS: 5, Burst: 10}
+	cluster.listConfigTweak(config)
+	cluster.watchConfigTweak(config)
+	assert.Equal(t, &rest.Config{QPS: 50, Burst: 10, Timeout: time.Minute}, config)
+	assert.True(t, cluster.debugLocking)
+	assert.Equal(t, 5, cap(cluster.liveQuerySlots))
+	assert.Equal(t, 1024, cluster.maxObjectSize)
+	assert.True(t, cluster.isExcludedInNamespace("noisy", schema.GroupKind{Kind: "Event"}))
+	assert.False(t, cluster.isExcludedInNamespace("default", schema.GroupKind{Kind: "Event"}))
+	assert.Equal(t, []schema.GroupKind{{Group: "apps", Kind: "Deployment"}}, cluster.priorityKinds)
+	assert.Equal(t, 100, cluster.maxResources)
+	assert.True(t, cluster.skipEndpointsOwnership)
+	assert.True(t, cluster.skipServiceAccountTokenOwnership)
+	assert.Equal(t, 3, cluster.maxSyncRetries)
+	assert.Equal(t, []schema.GroupKind{{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}}, cluster.includeClusterScopedKinds)
+	assert.True(t, cluster.lazyResourceInfo)
+	assert.Equal(t, time.Second, cluster.eventCoalesceWindow)
+	assert.Equal(t, schema.GroupKind{Group: "apps", Kind: "ReplicaSet"}, cluster.normalizeGroupKind(schema.GroupKind{Group: "extensions", Kind: "ReplicaSet"}))
+	assert.Equal(t, schema.GroupKind{Kind: "Pod"}, cluster.normalizeGroupKind(schema.GroupKind{Kind: "Pod"}))
+}
+
+func TestApplyWatchRetryBackoffDefaults(t *testing.T) {
+	cluster := newCluster()
+	applyResourceCacheSettings(cluster, &cacheSettings{ResourceCache: &settings.ResourceCacheSettings{
+		WatchRetryBackoff: &settings.WatchRetryBackoff{Cap: metav1.Duration{Duration: time.Minute}},
+	}})
+	assert.Equal(t, &wait.Backoff{Duration: watchResourcesRetryTimeout, Factor: 1, Cap: time.Minute}, cluster.watchRetryBackoff)
+}
+
+func TestGetClusterReportsCacheOverLimit(t *testing.T) {
+	server := "https://localhost:6443"
+	db := &dbmocks.ArgoDB{}
+	db.On("GetCluster", mock.Anything, server).Return(&appv1.Cluster{Server: server}, nil)
+	appLister := appinformer.NewSharedInformerFactory(appclientset.NewSimpleClientset(), 0).Argoproj().V1alpha1().Applications().Lister()
+	metricsServer := metrics.NewMetricsServer("localhost:8082", appLister, func() error { return nil })
+	cache := &liveStateCache{
+		db:                db,
+		lock:              &sync.Mutex{},
+		clusters:          make(map[string]*clusterInfo),
+		kubectl:           newCluster(testPod, testRS, testDeploy).kubectl,
+		metricsServer:     metricsServer,
+		cacheSettingsLock: &sync.Mutex{},
+		cacheSettings: &cacheSettings{
+			AppInstanceLabelKey: common.LabelKeyAppInstance,
+			ResourceCache:       &settings.ResourceCacheSettings{MaxResources: 2},
+		},
+	}
+
+	_, err := cache.getSyncedCluster(server)
+	assert.NoError(t, err)
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServer.Handler.ServeHTTP(rr, req)
+	assert.Contains(t, rr.Body.String(), `argocd_cluster_cache_over_limit_total{server="https://localhost:6443"} 1`)
+}
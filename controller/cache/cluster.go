@@ -2,42 +2,245 @@ package cache
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"math"
+	"reflect"
 	"runtime/debug"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
 
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/argoproj/argo-cd/controller/metrics"
 
 	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/diff"
 	"github.com/argoproj/argo-cd/util/health"
 	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/tracing"
 )
 
 const (
 	clusterSyncTimeout         = 24 * time.Hour
 	clusterRetryTimeout        = 10 * time.Second
 	watchResourcesRetryTimeout = 1 * time.Second
+	// minWatchRetryDelay is the minimal delay of the failed watch retry, so misconfigured backoff doesn't make failing
+	// watches hammer the API server in a hot loop
+	minWatchRetryDelay = 100 * time.Millisecond
+	// clusterConnectivityTimeout limits the duration of the connectivity check performed before the expensive sync
+	clusterConnectivityTimeout = 10 * time.Second
+	// defaultMaxConcurrentListsPerSync is the default number of resource lists performed simultaneously by the cluster sync
+	defaultMaxConcurrentListsPerSync = 10
+	// unhealthyWatchPeriod is the period after the latest failure of the kind watch during which the watch is not healthy
+	unhealthyWatchPeriod = 1 * time.Minute
 )
 
+// errResyncPeriodElapsed is returned by the watch to trigger re-listing of the kind
+var errResyncPeriodElapsed = fmt.Errorf("resync period has elapsed")
+
+// errRefreshRequested is returned by the watch to re-list the kind on demand
+var errRefreshRequested = fmt.Errorf("refresh has been requested")
+
+// errClusterCacheStopped is returned by the stopped cluster cache instead of syncing it
+var errClusterCacheStopped = fmt.Errorf("cluster cache has been stopped")
+
+// ErrClusterUnreachable is returned by the cluster sync if the cluster API server cannot be reached
+var ErrClusterUnreachable = fmt.Errorf("cluster is unreachable")
+
+// ErrSyncRetriesExhausted is returned instead of syncing the cluster once the number of consecutive sync failures
+// reaches the limit
+var ErrSyncRetriesExhausted = fmt.Errorf("giving up syncing cluster")
+
+// ServerVersionGroupKind is the key of the server version retrieval error in the sync errors. Failure to retrieve the
+// server version does not fail the sync.
+var ServerVersionGroupKind = schema.GroupKind{Kind: "ServerVersion"}
+
+var startMissingWatchesBackoff = wait.Backoff{
+	Steps:    10,
+	Duration: 1 * time.Second,
+	Factor:   2.0,
+	Jitter:   0.1,
+}
+
 type apiMeta struct {
-	namespaced      bool
-	resourceVersion string
-	watchCancel     context.CancelFunc
+	namespaced bool
+	// resourceVersions holds the resource version which the kind watch of each namespace resumes from. Watches of cluster
+	// level kinds and cluster-wide watches use the empty namespace. The watch re-lists resources if the version is missing.
+	resourceVersions map[string]string
+	watchCancel      context.CancelFunc
+	// watchCtx is the context of the kind watches; watches of namespaces added later are started within this context
+	watchCtx context.Context
+	// namespaceWatchCancels holds functions which cancel the kind watch of the individual namespace
+	namespaceWatchCancels map[string]context.CancelFunc
+	// watching is true while the watch of the kind is established
+	watching bool
+	// listed is true once resources of the kind have been listed either by the sync or by the watch
+	listed bool
+	// bookmarkTimes holds the time of the latest bookmark received by the kind watch of each namespace. Bookmark confirms
+	// that the watch is alive, so resources of the namespace are up to date even if they have not changed.
+	bookmarkTimes map[string]time.Time
+	// refreshChs holds the channels which are closed to make the running kind watch of each namespace re-list resources
+	refreshChs map[string]chan struct{}
+}
+
+func (m *apiMeta) resourceVersion(ns string) string {
+	return m.resourceVersions[ns]
+}
+
+func (m *apiMeta) setResourceVersion(ns string, resourceVersion string) {
+	if m.resourceVersions == nil {
+		m.resourceVersions = make(map[string]string)
+	}
+	m.resourceVersions[ns] = resourceVersion
+}
+
+// namespaceResourceVersions returns a copy of the resource versions of the kind watches of individual namespaces
+func (m *apiMeta) namespaceResourceVersions() map[string]string {
+	var res map[string]string
+	for ns, resourceVersion := range m.resourceVersions {
+		if ns == "" {
+			continue
+		}
+		if res == nil {
+			res = make(map[string]string)
+		}
+		res[ns] = resourceVersion
+	}
+	return res
+}
+
+// resetResourceVersion makes the kind watch of the namespace re-list resources once it is restarted
+func (m *apiMeta) resetResourceVersion(ns string) {
+	delete(m.resourceVersions, ns)
+}
+
+// refreshCh returns the channel which is closed once the kind watch of the namespace should re-list resources
+func (m *apiMeta) refreshCh(ns string) <-chan struct{} {
+	if m.refreshChs == nil {
+		m.refreshChs = make(map[string]chan struct{})
+	}
+	ch, ok := m.refreshChs[ns]
+	if !ok {
+		ch = make(chan struct{})
+		m.refreshChs[ns] = ch
+	}
+	return ch
+}
+
+// refresh resets the resource versions of all namespaces and stops the running watches of the kind, so the watch of
+// every namespace re-lists resources of its namespace
+func (m *apiMeta) refresh() {
+	m.resourceVersions = nil
+	for ns, ch := range m.refreshChs {
+		close(ch)
+		delete(m.refreshChs, ns)
+	}
+}
+
+// SyncStatus is the state of the cluster cache sync
+type SyncStatus string
+
+const (
+	// NotSynced means the cluster cache has never been synced or has been invalidated
+	NotSynced SyncStatus = "NotSynced"
+	// Syncing means the cluster cache sync is in progress
+	Syncing SyncStatus = "Syncing"
+	// Synced means the latest cluster cache sync has succeeded
+	Synced SyncStatus = "Synced"
+	// SyncFailed means the latest cluster cache sync has failed
+	SyncFailed SyncStatus = "SyncFailed"
+)
+
+// Reasons of the permanent stop of the kind watch reported to onWatchStopped
+const (
+	// WatchStopReasonNotFound means the kind is no longer served by the API server
+	WatchStopReasonNotFound = "NotFound"
+	// WatchStopReasonCRDDeleted means the CRD which defines the kind has been deleted
+	WatchStopReasonCRDDeleted = "CRDDeleted"
+	// WatchStopReasonEvicted means the kind has been evicted from the cache
+	WatchStopReasonEvicted = "Evicted"
+	// WatchStopReasonCacheStopped means the watch context has been cancelled because the cache has been stopped
+	WatchStopReasonCacheStopped = "CacheStopped"
+)
+
+// KindWatchConfig describes how a single kind is watched
+type KindWatchConfig struct {
+	GroupKind       schema.GroupKind `json:"groupKind"`
+	Namespaced      bool             `json:"namespaced"`
+	Namespaces      []string         `json:"namespaces,omitempty"`
+	LabelSelector   string           `json:"labelSelector,omitempty"`
+	FieldSelector   string           `json:"fieldSelector,omitempty"`
+	ResourceVersion string           `json:"resourceVersion"`
+	// ResourceVersions holds the resource versions of the kind watches of individual namespaces
+	ResourceVersions map[string]string `json:"resourceVersions,omitempty"`
+	Watching         bool              `json:"watching"`
+}
+
+// KindWatchStatus holds the history of watch failures of a single kind
+type KindWatchStatus struct {
+	GroupKind     schema.GroupKind `json:"groupKind"`
+	Forbidden     bool             `json:"forbidden"`
+	LastError     string           `json:"lastError,omitempty"`
+	LastErrorTime *time.Time       `json:"lastErrorTime,omitempty"`
+	Restarts      int              `json:"restarts"`
+}
+
+// EventStats holds the number of watch events of a single kind received since the stats have been reset
+type EventStats struct {
+	Added         int64     `json:"added"`
+	Modified      int64     `json:"modified"`
+	Deleted       int64     `json:"deleted"`
+	LastEventTime time.Time `json:"lastEventTime"`
+}
+
+// KindStatus describes the state of caching of a single kind
+type KindStatus struct {
+	// Listed is true once resources of the kind have been listed
+	Listed bool `json:"listed"`
+	// Watching is true while the watch of the kind is established
+	Watching        bool   `json:"watching"`
+	ResourceVersion string `json:"resourceVersion"`
+	// ResourceVersions holds the resource versions of the kind watches of individual namespaces
+	ResourceVersions map[string]string `json:"resourceVersions,omitempty"`
+	// LastError is the latest sync or watch error of the kind
+	LastError string `json:"lastError,omitempty"`
+}
+
+// WatchConfig describes the resolved watch configuration of a cluster
+type WatchConfig struct {
+	Server string            `json:"server"`
+	Kinds  []KindWatchConfig `json:"kinds"`
+}
+
+// ResourceSnapshot is a copy of the cached state of a single resource
+type ResourceSnapshot struct {
+	Ref             v1.ObjectReference      `json:"ref"`
+	OwnerRefs       []metav1.OwnerReference `json:"ownerRefs,omitempty"`
+	ResourceVersion string                  `json:"resourceVersion"`
+	ManifestCached  bool                    `json:"manifestCached"`
+	LastUpdated     time.Time               `json:"lastUpdated"`
 }
 
 type clusterInfo struct {
@@ -45,17 +248,279 @@ type clusterInfo struct {
 	syncError     error
 	apisMeta      map[schema.GroupKind]*apiMeta
 	serverVersion string
+	// syncErrors holds errors of kinds which failed to sync. Sync succeeds if at least one kind is successfully synced.
+	syncErrors map[schema.GroupKind]error
 
-	lock    *sync.Mutex
+	// lock guards the cache state; read-only queries take a read lock so they don't serialize behind each other
+	lock    *sync.RWMutex
 	nodes   map[kube.ResourceKey]*node
 	nsIndex map[string]map[kube.ResourceKey]*node
 
-	onObjectUpdated  ObjectUpdatedHandler
+	onObjectUpdated ObjectUpdatedHandler
+	// onObjectsRemoved, if set, is notified once per relist about all removed objects instead of onObjectUpdated per object
+	onObjectsRemoved ObjectsRemovedHandler
 	onEventReceived  func(event watch.EventType, un *unstructured.Unstructured)
+	// onObjectRemoved, if set, is notified about removed object instead of onObjectUpdated with the Deleted event, so that
+	// onObjectUpdated receives only added and modified objects
+	onObjectRemoved ObjectRemovedHandler
+	// onParentResolved, if set, is notified about every cached child of the newly added resource, so consumers reacting to
+	// updates of individual objects can attach children which have been added before their owner
+	onParentResolved ParentResolvedHandler
+	// onWatchStopped, if set, is notified when the watch of the kind is stopped and is not going to be restarted until
+	// the kind is rediscovered. Watches cancelled by the cache invalidation are not reported since the next sync restarts
+	// them.
+	onWatchStopped func(gk schema.GroupKind, reason string)
+	// onSyncStateChanged is notified when cluster sync starts failing or recovers
+	onSyncStateChanged func(nowHealthy bool, err error)
+	// onSyncCompleted, if set, is notified when full cluster sync completes either successfully or with an error
+	onSyncCompleted func(err error)
+	// onResourceInfoChanged, if set, is notified when the information computed for an updated resource (info items,
+	// networking info, images and health) changes, so consumers can react to semantic changes rather than to all updates
+	onResourceInfoChanged func(oldRes, newRes appv1.ResourceNode)
+	// resourceInfoEqual, if set, overrides the comparison of the resource information used to detect info changes
+	resourceInfoEqual func(oldRes, newRes *appv1.ResourceNode) bool
+	// onPanic, if set, is notified about panics recovered in watch goroutines, so they can be reported to external monitoring
+	onPanic func(gk schema.GroupKind, recovered interface{}, stack []byte)
+	// watchRetryBackoff, if set, makes the watch retry delay grow exponentially on consecutive failures up to the backoff
+	// cap, so that reconnecting watches don't overload the API server. The delay is reset once the watch is established.
+	// Watch is retried every second if not set.
+	watchRetryBackoff *wait.Backoff
+	// metricsRecorder, if set, is notified about watch reconnects and list durations
+	metricsRecorder  WatchMetricsRecorder
 	kubectl          kube.Kubectl
 	cluster          *appv1.Cluster
 	log              *log.Entry
 	cacheSettingsSrc func() *cacheSettings
+	// discoverer, if set, is used instead of kubectl to retrieve the server version and the API resources
+	discoverer Discoverer
+	// debugLocking makes accessing the cache from a handler invoked under the cluster lock panic instead of deadlocking
+	debugLocking   bool
+	handlerTracker handlerTracker
+	// listConfigTweak, if set, modifies the REST config of the client used to list resources, e.g. to increase QPS
+	listConfigTweak func(config *rest.Config)
+	// watchConfigTweak, if set, modifies the REST config of the client used to watch resources, e.g. to change timeouts
+	watchConfigTweak func(config *rest.Config)
+	// maxObjectSize limits the size in bytes of the JSON representation of cached manifests, so a single huge resource
+	// such as a multi-megabyte ConfigMap doesn't blow the memory. Larger resources are cached without manifest and are
+	// loaded from the cluster when needed. Zero means no limit.
+	maxObjectSize int
+	// maxResources is the soft limit of the number of cached resources. Exceeding the limit does not prevent caching, but
+	// a warning is logged and onCacheOverLimit is notified, so operators can shed the load e.g. by evicting noisy kinds.
+	// Zero means no limit.
+	maxResources int
+	// onCacheOverLimit, if set, is notified when the number of cached resources exceeds maxResources. It is notified again
+	// only after the number of resources drops below the limit and exceeds it once more.
+	onCacheOverLimit func(count int, limit int)
+	// overLimit is true if the number of cached resources exceeds maxResources
+	overLimit bool
+	// skipNoOpUpdates makes the cache skip notifying onObjectUpdated about modifications which change neither the spec,
+	// labels, annotations and owner references of the resource nor the cached manifest and the computed resource info,
+	// e.g. changes of the managed fields only. The cached node is updated anyway.
+	skipNoOpUpdates bool
+	// eventCoalesceWindow, if positive, makes the cache notify onObjectUpdated about the changes of each resource at most
+	// once per window: notifications are collected per resource key and flushed in batches, so a storm of updates of the
+	// same resource results in a single notification. Cached resources are updated immediately anyway.
+	eventCoalesceWindow time.Duration
+	// preferCachedNodes makes getManagedLiveObjs build a minimal object from the cached node instead of
+	// loading the full manifest from the cluster when the node has no cached manifest
+	preferCachedNodes bool
+	// maxConcurrentLiveQueries limits the number of resources concurrently loaded or converted by getManagedLiveObjs when
+	// they are missing in the cache. Queries are still throttled by the QPS/Burst of the cluster REST config, so the limit
+	// above Burst only makes queries wait in the client side rate limiter. Zero means no limit.
+	maxConcurrentLiveQueries int
+	// liveQuerySlots is the semaphore which limits the number of concurrent live queries
+	liveQuerySlots chan struct{}
+	// lazyResourceInfo makes the cache compute the info, networking info and images of the resource on the first access
+	// rather than when the resource is listed or watched, which cuts the sync time of large clusters. The resource is
+	// retained in memory until the info is computed. Health is still assessed eagerly.
+	lazyResourceInfo bool
+	// preserveNodeInfo makes sync reuse the info of nodes whose resource version has not changed since the previous sync
+	preserveNodeInfo bool
+	// syncTimeout overrides the period after which the cluster is fully re-synced. Zero means clusterSyncTimeout.
+	syncTimeout time.Duration
+	// retryTimeout overrides the period after which the failed cluster sync is retried. Zero means clusterRetryTimeout.
+	retryTimeout time.Duration
+	// resyncPeriodOverrides holds periods after which resources of the specific kind are re-listed. Periods should be
+	// shorter than clusterSyncTimeout since full cluster sync re-lists all kinds anyway.
+	resyncPeriodOverrides map[schema.GroupKind]time.Duration
+	// trimObjectMeta makes the cache remove heavy annotations such as kubectl last-applied-configuration from the received
+	// objects. Note that the three-way diff of managed resources falls back to the two-way diff without the annotation.
+	trimObjectMeta bool
+	// controllerOwnerRefsOnly makes the cache ignore owner references which are not marked as controller, so the resources
+	// hierarchy matches what controllers actually manage. Synthetic owner references are not affected.
+	controllerOwnerRefsOnly bool
+	// skipEndpointsOwnership makes the cache not consider endpoints as children of the service with the same name
+	skipEndpointsOwnership bool
+	// skipServiceAccountTokenOwnership makes the cache not consider auto-created service account token secrets as
+	// children of the service account. Newer Kubernetes versions don't create such secrets anyway.
+	skipServiceAccountTokenOwnership bool
+	// ownerResolver, if set, returns additional owner references of the resource, so resources related by labels or
+	// annotations rather than by owner references are included in the resources hierarchy. Resolved owner references
+	// without UID are matched by the owner kind, API version and name.
+	ownerResolver func(un *unstructured.Unstructured) []metav1.OwnerReference
+	// groupKindNormalizer, if set, maps the group kind of the resource to the canonical one (e.g. extensions ReplicaSet to
+	// apps ReplicaSet) when computing resource keys and resolving owners, so the same resource served by several API
+	// groups is cached only once
+	groupKindNormalizer func(gk schema.GroupKind) schema.GroupKind
+	// healthOverride, if set, assesses the health of cached resources in place of the resource overrides and the built-in
+	// health checks. Several overrides can be chained using health.CompositeHealthOverride.
+	healthOverride health.HealthOverride
+	// priorityKinds holds kinds which are listed and watched before the rest of the kinds, so listing of the most needed
+	// kinds isn't slowed down by the client side throttling caused by thousands of events. Resources of priority kinds
+	// are published to the readers of these kinds (see ensureKindsSynced) before the rest of the kinds are listed, while
+	// synced() keeps meaning that every kind has been listed.
+	priorityKinds []schema.GroupKind
+	// maxConcurrentListsPerSync limits the number of resource lists performed simultaneously by the cluster sync, so the
+	// sync of a cluster with hundreds of kinds doesn't trip the client side throttling. Zero means
+	// defaultMaxConcurrentListsPerSync, negative value means no limit.
+	maxConcurrentListsPerSync int
+	// listPageSize limits the number of resources retrieved by a single list request, so that listing of kinds with a huge
+	// number of resources doesn't spike the API server and controller memory. Zero means no limit.
+	listPageSize int64
+	// minResourceVersion, if set, makes the cache list resources which state is not older than the given resource version,
+	// so the cache never starts from a state older than a known write. Lists with the minimal resource version are not
+	// paginated. If the API server rejects the list, resources are listed with the default consistency.
+	minResourceVersion string
+	// preferredVersionsOnly makes the cache list and watch only resources served by the preferred version of each
+	// group, so resources available only in older versions of a multi-version group are not cached
+	preferredVersionsOnly bool
+	// trackWatchBytes enables accounting of the watch traffic per kind. The traffic is estimated using the size of the
+	// JSON representation of received objects, so it has a CPU cost.
+	trackWatchBytes bool
+	// resourceLabelSelector limits cached resources to the ones matching the selector. Nil or empty selector means all
+	// resources are cached. Note that the resources hierarchy might be incomplete if parents don't match the selector.
+	resourceLabelSelector labels.Selector
+	// namespaceResourceFilter, if set, is consulted in addition to the global resources filter when resources of the cached
+	// namespaces are listed and watched, and returns true if the kind should not be cached in the given namespace. It
+	// allows excluding heavyweight kinds from noisy namespaces. It is not applied to the cluster level cache.
+	namespaceResourceFilter func(ns string, gk schema.GroupKind) bool
+	// includeClusterScopedKinds holds cluster level kinds which are listed and watched cluster-wide even if the cache is
+	// limited to the specific namespaces, e.g. cluster roles bound within the namespaces. Such resources are indexed under
+	// the empty namespace.
+	includeClusterScopedKinds []schema.GroupKind
+	// fieldSelectors holds field selectors applied when listing and watching resources of the specific kind. Kinds without
+	// a selector are cached entirely.
+	fieldSelectors map[schema.GroupKind]string
+	// disabledFieldSelectors holds kinds whose field selector has been rejected by the API server. Such kinds are cached
+	// without the field selector. It is guarded by fieldSelectorsLock since kinds are listed concurrently during sync.
+	disabledFieldSelectors map[schema.GroupKind]bool
+	fieldSelectorsLock     sync.Mutex
+	// watchBookmarks makes watches request bookmark events, which advance the watched resource version without changes
+	// and so reduce the number of full re-lists caused by too old resource version. Older API servers don't support it.
+	watchBookmarks bool
+	// namespaceWatchConcurrency limits the number of namespace watches being established simultaneously, so starting
+	// watches of many namespaces doesn't hit the API server with a burst of requests. Zero means no limit.
+	namespaceWatchConcurrency int
+	// namespaceWatchSlots is the semaphore which limits the number of namespace watches being established
+	namespaceWatchSlots chan struct{}
+	// watchEstablishRateLimiter, if set, paces watch requests so the API servers with aggressive limits of in-flight
+	// requests don't reject watches started at once with 429 errors
+	watchEstablishRateLimiter flowcontrol.RateLimiter
+
+	// generation is incremented on every node change
+	generation uint64
+	// changedKeys holds the generation of the latest change of every key changed since the last full sync
+	changedKeys map[kube.ResourceKey]uint64
+	// changesResetGeneration is the generation at which the last full sync reset the change tracking
+	changesResetGeneration uint64
+
+	// unresolvedChildren holds keys of the cached children which inferred owner references have no UID, by the key of the
+	// owner which is not cached yet
+	unresolvedChildren map[kube.ResourceKey]map[kube.ResourceKey]bool
+
+	// pendingNotifications holds onObjectUpdated notifications collected during the event coalesce window
+	pendingNotifications map[kube.ResourceKey]*pendingNotification
+	// coalesceDone is closed to stop the running pending notifications flush loop; nil if the loop is not running
+	coalesceDone chan struct{}
+	// coalesceTicker, if set, replaces the ticker which triggers the flush of the pending notifications
+	coalesceTicker func(window time.Duration) (<-chan time.Time, func())
+
+	// invalidatedNamespaces holds namespaces which resources have been evicted and should be re-listed by the next
+	// ensureSynced call
+	invalidatedNamespaces map[string]bool
+
+	// syncing is 1 while the sync is in progress. Sync holds the cluster lock, so the flag is accessed atomically.
+	syncing int32
+	// syncDone is closed once the sync in progress completes. The sync releases the cluster lock while listing the rest
+	// of the kinds after the priority ones, so ensureSynced callers wait for the channel instead of starting another sync.
+	syncDone chan struct{}
+	// cancelSync interrupts the sync in progress, so the cache invalidated while the sync has released the lock is
+	// synced again using the new settings
+	cancelSync context.CancelFunc
+	// syncedKinds holds normalized kinds which resources have been listed and published by the sync in progress
+	syncedKinds map[schema.GroupKind]bool
+
+	// lastSyncFailed is true if the latest sync has failed
+	lastSyncFailed bool
+
+	// maxSyncRetries limits the number of consecutive sync failures after which the cache stops retrying the sync until it
+	// is invalidated. Zero means the sync is retried forever.
+	maxSyncRetries int
+	// syncFailures is the number of consecutive sync failures
+	syncFailures int
+
+	// watchStatus holds the watch failures history per kind. It is preserved across invalidations and can be exported
+	// and imported to survive restarts.
+	watchStatus map[schema.GroupKind]*KindWatchStatus
+
+	eventsSubscribers []*eventsSubscriber
+	// droppedEventsCount is the number of events dropped since the cache has been created, including events dropped by
+	// subscribers which have unsubscribed since then
+	droppedEventsCount int
+
+	// eventProcessingLag is the time between arrival of the latest watch event and the end of its processing
+	eventProcessingLag time.Duration
+
+	// watchBytesReceived holds the estimated number of bytes received by the watches of each kind. It is preserved across
+	// invalidations.
+	watchBytesReceived map[schema.GroupKind]int64
+
+	// eventStats holds the number of watch events received for each kind. It is preserved across invalidations.
+	eventStats map[schema.GroupKind]*EventStats
+
+	// evictedKinds holds kinds which are neither listed nor watched until they are unevicted. It is preserved across
+	// invalidations.
+	evictedKinds map[schema.GroupKind]bool
+
+	// stopped is true if the cache has been permanently stopped
+	stopped bool
+
+	// apiWarnings holds warnings such as API deprecation warnings returned by the API server for the cached kinds
+	apiWarnings apiWarnings
+}
+
+// normalizeGroupKind returns the canonical group kind of the resource if the group kind normalizer is configured
+func (c *clusterInfo) normalizeGroupKind(gk schema.GroupKind) schema.GroupKind {
+	if c.groupKindNormalizer == nil {
+		return gk
+	}
+	return c.groupKindNormalizer(gk)
+}
+
+// normalizeKey returns the resource key with the canonical group kind
+func (c *clusterInfo) normalizeKey(key kube.ResourceKey) kube.ResourceKey {
+	gk := c.normalizeGroupKind(key.GroupKind())
+	return kube.NewResourceKey(gk.Group, gk.Kind, key.Namespace, key.Name)
+}
+
+// getResourceKey returns the key of the resource in the cache
+func (c *clusterInfo) getResourceKey(un *unstructured.Unstructured) kube.ResourceKey {
+	return c.normalizeKey(kube.GetResourceKey(un))
+}
+
+// nodeKey returns the key of the node in the cache
+func (c *clusterInfo) nodeKey(n *node) kube.ResourceKey {
+	return c.normalizeKey(n.resourceKey())
+}
+
+// getAPIMeta returns the meta of the kind served either by the given API group or by its canonical group. Resources of
+// both groups are cached under the canonical key.
+func (c *clusterInfo) getAPIMeta(gk schema.GroupKind) (*apiMeta, bool) {
+	if info, ok := c.apisMeta[gk]; ok {
+		return info, true
+	}
+	info, ok := c.apisMeta[c.normalizeGroupKind(gk)]
+	return info, ok
 }
 
 func (c *clusterInfo) replaceResourceCache(gk schema.GroupKind, resourceVersion string, objs []unstructured.Unstructured, ns string) {
@@ -63,28 +528,41 @@ func (c *clusterInfo) replaceResourceCache(gk schema.GroupKind, resourceVersion
 	if ok {
 		objByKey := make(map[kube.ResourceKey]*unstructured.Unstructured)
 		for i := range objs {
-			objByKey[kube.GetResourceKey(&objs[i])] = &objs[i]
+			objByKey[c.getResourceKey(&objs[i])] = &objs[i]
 		}
 
 		// update existing nodes
 		for i := range objs {
 			obj := &objs[i]
-			key := kube.GetResourceKey(&objs[i])
+			key := c.getResourceKey(&objs[i])
 			existingNode, exists := c.nodes[key]
 			c.onNodeUpdated(exists, existingNode, obj, key)
 		}
 
 		// remove existing nodes that a no longer exist
+		removed := make([]v1.ObjectReference, 0)
+		normalizedGK := c.normalizeGroupKind(gk)
 		for key, existingNode := range c.nodes {
-			if key.Kind != gk.Kind || key.Group != gk.Group || ns != "" && key.Namespace != ns {
+			if key.Kind != normalizedGK.Kind || key.Group != normalizedGK.Group || ns != "" && key.Namespace != ns {
 				continue
 			}
 
 			if _, ok := objByKey[key]; !ok {
-				c.onNodeRemoved(key, existingNode)
+				if c.onObjectsRemoved != nil {
+					c.removeNode(key)
+					c.publishEvent(watch.Deleted, nil, existingNode)
+					removed = append(removed, existingNode.ref)
+				} else {
+					c.onNodeRemoved(key, existingNode)
+				}
 			}
 		}
-		info.resourceVersion = resourceVersion
+		if len(removed) > 0 {
+			c.invokeHandler("ObjectsRemoved", kube.NewResourceKey(gk.Group, gk.Kind, ns, ""), func() {
+				c.onObjectsRemoved(removed)
+			})
+		}
+		info.setResourceVersion(ns, resourceVersion)
 	}
 }
 
@@ -115,10 +593,42 @@ func isServiceAccountTokenSecret(un *unstructured.Unstructured) (bool, metav1.Ow
 	return ref.Name != "" && ref.UID != "", ref
 }
 
+// normalizeOwnerRef replaces the owner API group with the canonical one, so the owner is resolved by its cache key
+func (c *clusterInfo) normalizeOwnerRef(ownerRef metav1.OwnerReference) metav1.OwnerReference {
+	if c.groupKindNormalizer == nil {
+		return ownerRef
+	}
+	gv := ownerRefGV(ownerRef)
+	gk := c.groupKindNormalizer(schema.GroupKind{Group: gv.Group, Kind: ownerRef.Kind})
+	if gk.Group != gv.Group || gk.Kind != ownerRef.Kind {
+		ownerRef.APIVersion = schema.GroupVersion{Group: gk.Group, Version: gv.Version}.String()
+		ownerRef.Kind = gk.Kind
+	}
+	return ownerRef
+}
+
 func (c *clusterInfo) createObjInfo(un *unstructured.Unstructured, appInstanceLabel string) *node {
-	ownerRefs := un.GetOwnerReferences()
+	return c.createObjInfoFromPrevious(un, appInstanceLabel, nil)
+}
+
+// createObjInfoFromPrevious creates the node and reuses computed info of the previous node if the resource version has not changed
+func (c *clusterInfo) createObjInfoFromPrevious(un *unstructured.Unstructured, appInstanceLabel string, prev *node) *node {
+	if c.trimObjectMeta {
+		trimObjectMeta(un)
+	}
+	ownerRefs := make([]metav1.OwnerReference, 0)
+	for _, ownerRef := range un.GetOwnerReferences() {
+		// skip malformed self-referencing owner references
+		if ownerRef.UID != "" && ownerRef.UID == un.GetUID() {
+			continue
+		}
+		if c.controllerOwnerRefsOnly && !isControllerRef(ownerRef) {
+			continue
+		}
+		ownerRefs = append(ownerRefs, c.normalizeOwnerRef(ownerRef))
+	}
 	// Special case for endpoint. Remove after https://github.com/kubernetes/kubernetes/issues/28483 is fixed
-	if un.GroupVersionKind().Group == "" && un.GetKind() == kube.EndpointsKind && len(un.GetOwnerReferences()) == 0 {
+	if !c.skipEndpointsOwnership && un.GroupVersionKind().Group == "" && un.GetKind() == kube.EndpointsKind && len(un.GetOwnerReferences()) == 0 {
 		ownerRefs = append(ownerRefs, metav1.OwnerReference{
 			Name:       un.GetName(),
 			Kind:       kube.ServiceKind,
@@ -127,28 +637,117 @@ func (c *clusterInfo) createObjInfo(un *unstructured.Unstructured, appInstanceLa
 	}
 
 	// edge case. Consider auto-created service account tokens as a child of service account objects
-	if yes, ref := isServiceAccountTokenSecret(un); yes {
+	if yes, ref := isServiceAccountTokenSecret(un); yes && !c.skipServiceAccountTokenOwnership {
 		ownerRefs = append(ownerRefs, ref)
 	}
 
+	if c.ownerResolver != nil {
+		for _, ownerRef := range c.ownerResolver(un) {
+			if ownerRef.UID != "" && ownerRef.UID == un.GetUID() {
+				continue
+			}
+			ownerRefs = append(ownerRefs, c.normalizeOwnerRef(ownerRef))
+		}
+	}
+
 	nodeInfo := &node{
 		resourceVersion: un.GetResourceVersion(),
 		ref:             kube.GetObjectRef(un),
 		ownerRefs:       ownerRefs,
+		labels:          un.GetLabels(),
+		lastUpdated:     time.Now(),
 	}
 
-	populateNodeInfo(un, nodeInfo)
+	if prev != nil && prev.resourceVersion == nodeInfo.resourceVersion {
+		// nodes are created under the cache write lock, so the previous node info is not populated concurrently
+		nodeInfo.unpopulated = prev.unpopulated
+		nodeInfo.info = prev.info
+		nodeInfo.networkingInfo = prev.networkingInfo
+		nodeInfo.images = prev.images
+	} else if c.lazyResourceInfo {
+		nodeInfo.unpopulated = un
+	} else {
+		populateNodeInfo(un, nodeInfo)
+	}
 	appName := kube.GetAppInstanceLabel(un, appInstanceLabel)
 	if len(ownerRefs) == 0 && appName != "" {
 		nodeInfo.appName = appName
-		nodeInfo.resource = un
+		if c.exceedsMaxObjectSize(un) {
+			nodeInfo.oversized = true
+		} else {
+			nodeInfo.resource = un
+		}
+	}
+	nodeInfo.health, _ = health.GetResourceHealthWithOverride(un, c.cacheSettingsSrc().ResourceOverrides, c.healthOverride)
+	if c.skipNoOpUpdates {
+		nodeInfo.contentHash = contentHash(un, nodeInfo.resource != nil)
 	}
-	nodeInfo.health, _ = health.GetResourceHealth(un, c.cacheSettingsSrc().ResourceOverrides)
 	return nodeInfo
 }
 
+// contentHash returns the hash of the resource spec, labels, annotations and owner references. If the manifest is
+// cached then the whole manifest except the fields updated by the server on every change is hashed.
+func contentHash(un *unstructured.Unstructured, manifestCached bool) uint64 {
+	var content interface{}
+	if manifestCached {
+		obj := un.DeepCopy()
+		unstructured.RemoveNestedField(obj.Object, "metadata", "managedFields")
+		unstructured.RemoveNestedField(obj.Object, "metadata", "resourceVersion")
+		content = obj.Object
+	} else {
+		content = map[string]interface{}{
+			"spec":            un.Object["spec"],
+			"labels":          un.GetLabels(),
+			"annotations":     un.GetAnnotations(),
+			"ownerReferences": un.GetOwnerReferences(),
+		}
+	}
+	data, err := json.Marshal(content)
+	if err != nil {
+		return 0
+	}
+	h := fnv.New64a()
+	_, _ = h.Write(data)
+	return h.Sum64()
+}
+
+// exceedsMaxObjectSize returns true if the manifest of the given resource is too large to be cached
+func (c *clusterInfo) exceedsMaxObjectSize(un *unstructured.Unstructured) bool {
+	if c.maxObjectSize <= 0 {
+		return false
+	}
+	data, err := un.MarshalJSON()
+	if err != nil || len(data) <= c.maxObjectSize {
+		return false
+	}
+	c.log.Warnf("Manifest of %s is not cached: size %d exceeds the limit of %d bytes", kube.GetResourceKey(un), len(data), c.maxObjectSize)
+	return true
+}
+
+func (c *clusterInfo) recordChange(key kube.ResourceKey) {
+	c.generation++
+	if c.changedKeys == nil {
+		c.changedKeys = make(map[kube.ResourceKey]uint64)
+	}
+	c.changedKeys[key] = c.generation
+}
+
+// trimObjectMeta removes heavy annotations which are not used by the cache
+func trimObjectMeta(un *unstructured.Unstructured) {
+	annotations := un.GetAnnotations()
+	if _, ok := annotations[v1.LastAppliedConfigAnnotation]; ok {
+		delete(annotations, v1.LastAppliedConfigAnnotation)
+		un.SetAnnotations(annotations)
+	}
+}
+
 func (c *clusterInfo) setNode(n *node) {
-	key := n.resourceKey()
+	key := c.nodeKey(n)
+	c.recordChange(key)
+	if existing, ok := c.nodes[key]; ok {
+		// owner references of the updated resource might have changed
+		c.forgetUnresolvedChild(key, existing)
+	}
 	c.nodes[key] = n
 	ns, ok := c.nsIndex[key.Namespace]
 	if !ok {
@@ -156,9 +755,102 @@ func (c *clusterInfo) setNode(n *node) {
 		c.nsIndex[key.Namespace] = ns
 	}
 	ns[key] = n
+	c.resolveInferredOwners(key, n)
+	c.checkResourcesLimit(key)
+}
+
+// resolveInferredOwners backfills UIDs of the inferred owner references, such as references of endpoints to services,
+// once both the owner and the child are cached. Nodes are read concurrently under the cache read lock, so owner
+// references are modified only here, under the write lock.
+func (c *clusterInfo) resolveInferredOwners(key kube.ResourceKey, n *node) {
+	for i, ownerRef := range n.ownerRefs {
+		if ownerRef.UID != "" {
+			continue
+		}
+		for _, ownerKey := range c.inferredOwnerKeys(n, ownerRef) {
+			if owner, ok := c.nodes[ownerKey]; ok && owner.isInferredOwnerOf(n, ownerRef) {
+				n.ownerRefs[i].UID = owner.ref.UID
+				break
+			}
+			if c.unresolvedChildren == nil {
+				c.unresolvedChildren = make(map[kube.ResourceKey]map[kube.ResourceKey]bool)
+			}
+			if c.unresolvedChildren[ownerKey] == nil {
+				c.unresolvedChildren[ownerKey] = make(map[kube.ResourceKey]bool)
+			}
+			c.unresolvedChildren[ownerKey][key] = true
+		}
+	}
+
+	children, ok := c.unresolvedChildren[key]
+	if !ok {
+		return
+	}
+	delete(c.unresolvedChildren, key)
+	for childKey := range children {
+		child, ok := c.nodes[childKey]
+		if !ok {
+			continue
+		}
+		for i, ownerRef := range child.ownerRefs {
+			if ownerRef.UID == "" && n.isInferredOwnerOf(child, ownerRef) {
+				child.ownerRefs[i].UID = n.ref.UID
+			}
+		}
+	}
+}
+
+// inferredOwnerKeys returns the possible keys of the owner referenced without UID. The owner must be either in the same
+// namespace or cluster level.
+func (c *clusterInfo) inferredOwnerKeys(n *node, ownerRef metav1.OwnerReference) []kube.ResourceKey {
+	gv := ownerRefGV(ownerRef)
+	return []kube.ResourceKey{
+		c.normalizeKey(kube.NewResourceKey(gv.Group, ownerRef.Kind, n.ref.Namespace, ownerRef.Name)),
+		c.normalizeKey(kube.NewResourceKey(gv.Group, ownerRef.Kind, "", ownerRef.Name)),
+	}
+}
+
+// forgetUnresolvedChild removes the child from the children waiting for their owners, so entries of removed and
+// updated children don't accumulate
+func (c *clusterInfo) forgetUnresolvedChild(key kube.ResourceKey, n *node) {
+	if len(c.unresolvedChildren) == 0 {
+		return
+	}
+	for _, ownerRef := range n.ownerRefs {
+		for _, ownerKey := range c.inferredOwnerKeys(n, ownerRef) {
+			if children, ok := c.unresolvedChildren[ownerKey]; ok {
+				delete(children, key)
+				if len(children) == 0 {
+					delete(c.unresolvedChildren, ownerKey)
+				}
+			}
+		}
+	}
+}
+
+// checkResourcesLimit warns about the number of cached resources once it exceeds the soft limit
+func (c *clusterInfo) checkResourcesLimit(key kube.ResourceKey) {
+	if c.maxResources <= 0 {
+		return
+	}
+	overLimit := len(c.nodes) > c.maxResources
+	if overLimit && !c.overLimit {
+		count := len(c.nodes)
+		c.log.Warnf("Number of cached resources %d exceeds the limit %d", count, c.maxResources)
+		if c.onCacheOverLimit != nil {
+			c.invokeHandler("CacheOverLimit", key, func() {
+				c.onCacheOverLimit(count, c.maxResources)
+			})
+		}
+	}
+	c.overLimit = overLimit
 }
 
 func (c *clusterInfo) removeNode(key kube.ResourceKey) {
+	c.recordChange(key)
+	if n, ok := c.nodes[key]; ok {
+		c.forgetUnresolvedChild(key, n)
+	}
 	delete(c.nodes, key)
 	if ns, ok := c.nsIndex[key.Namespace]; ok {
 		delete(ns, key)
@@ -166,16 +858,57 @@ func (c *clusterInfo) removeNode(key kube.ResourceKey) {
 			delete(c.nsIndex, key.Namespace)
 		}
 	}
+	if c.overLimit && len(c.nodes) <= c.maxResources {
+		c.overLimit = false
+	}
 }
 
-func (c *clusterInfo) invalidate() {
+// invalidate makes the next ensureSynced call re-sync the cluster. The given functions update the cache settings under
+// the cluster lock, so the next sync uses the new settings.
+func (c *clusterInfo) invalidate(updateSettings ...func(c *clusterInfo)) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	for _, update := range updateSettings {
+		update(c)
+	}
 	c.syncTime = nil
+	c.syncFailures = 0
+	c.interruptSync()
 	for i := range c.apisMeta {
 		c.apisMeta[i].watchCancel()
 	}
 	c.apisMeta = nil
+	c.flushPendingNotifications()
+	c.stopCoalescing()
+}
+
+// interruptSync cancels the sync in progress, if any. The interrupted sync is started again by the ensureSynced call
+// which has started it. The caller must hold the cluster lock.
+func (c *clusterInfo) interruptSync() {
+	if c.cancelSync != nil {
+		c.cancelSync()
+	}
+}
+
+// stop permanently stops all watches and releases cached resources. Stopped cache never syncs again and returns empty
+// results.
+func (c *clusterInfo) stop() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.stopped = true
+	c.syncTime = nil
+	c.interruptSync()
+	for gk := range c.apisMeta {
+		c.apisMeta[gk].watchCancel()
+		c.notifyWatchStopped(gk, WatchStopReasonCacheStopped)
+	}
+	c.apisMeta = nil
+	c.nodes = nil
+	c.nsIndex = nil
+	c.unresolvedChildren = nil
+	c.changedKeys = nil
+	c.pendingNotifications = nil
+	c.stopCoalescing()
 }
 
 func (c *clusterInfo) synced() bool {
@@ -183,12 +916,20 @@ func (c *clusterInfo) synced() bool {
 		return false
 	}
 	if c.syncError != nil {
-		return time.Now().Before(c.syncTime.Add(clusterRetryTimeout))
+		retryTimeout := clusterRetryTimeout
+		if c.retryTimeout > 0 {
+			retryTimeout = c.retryTimeout
+		}
+		return time.Now().Before(c.syncTime.Add(retryTimeout))
 	}
-	return time.Now().Before(c.syncTime.Add(clusterSyncTimeout))
+	syncTimeout := clusterSyncTimeout
+	if c.syncTimeout > 0 {
+		syncTimeout = c.syncTimeout
+	}
+	return time.Now().Before(c.syncTime.Add(syncTimeout))
 }
 
-func (c *clusterInfo) stopWatching(gk schema.GroupKind, ns string) {
+func (c *clusterInfo) stopWatching(gk schema.GroupKind, ns string, reason string) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	if info, ok := c.apisMeta[gk]; ok {
@@ -196,34 +937,199 @@ func (c *clusterInfo) stopWatching(gk schema.GroupKind, ns string) {
 		delete(c.apisMeta, gk)
 		c.replaceResourceCache(gk, "", []unstructured.Unstructured{}, ns)
 		log.Warnf("Stop watching %s not found on %s.", gk, c.cluster.Server)
+		c.notifyWatchStopped(gk, reason)
+	}
+}
+
+// notifyWatchStopped notifies the handler about the permanently stopped watch of the kind
+func (c *clusterInfo) notifyWatchStopped(gk schema.GroupKind, reason string) {
+	if c.onWatchStopped == nil {
+		return
+	}
+	c.invokeHandler("WatchStopped", kube.ResourceKey{Group: gk.Group, Kind: gk.Kind}, func() {
+		c.onWatchStopped(gk, reason)
+	})
+}
+
+// evictKind stops watching the given kind and removes its resources from the cache. The kind is not listed and watched
+// again, even by the full cluster sync, until it is unevicted.
+func (c *clusterInfo) evictKind(gk schema.GroupKind) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.stopped {
+		return errClusterCacheStopped
+	}
+	if c.evictedKinds == nil {
+		c.evictedKinds = make(map[schema.GroupKind]bool)
+	}
+	c.evictedKinds[gk] = true
+	if info, ok := c.apisMeta[gk]; ok {
+		info.watchCancel()
+		delete(c.apisMeta, gk)
+		c.notifyWatchStopped(gk, WatchStopReasonEvicted)
+	}
+	for key, n := range c.nodes {
+		if key.Group == gk.Group && key.Kind == gk.Kind {
+			c.onNodeRemoved(key, n)
+		}
+	}
+	c.log.Infof("Evicted %s from the cache", gk)
+	return nil
+}
+
+// unevictKind allows caching of the previously evicted kind. Resources of the kind are listed and watched right away
+// if the cluster is synced or by the next sync otherwise.
+func (c *clusterInfo) unevictKind(gk schema.GroupKind) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.stopped {
+		return errClusterCacheStopped
+	}
+	if !c.evictedKinds[gk] {
+		return nil
+	}
+	delete(c.evictedKinds, gk)
+	if !c.synced() || c.apisMeta == nil {
+		// the kind is going to be listed by the next sync
+		return nil
+	}
+	return c.startMissingWatches()
+}
+
+// refreshKind makes the watches of the given kind re-list its resources, so the cached resources are reconciled with
+// the cluster without invalidating the whole cache
+func (c *clusterInfo) refreshKind(gk schema.GroupKind) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.stopped {
+		return errClusterCacheStopped
+	}
+	if !c.synced() || c.apisMeta == nil {
+		// all resources are going to be listed by the next sync
+		return nil
+	}
+	info, ok := c.apisMeta[gk]
+	if !ok {
+		return fmt.Errorf("kind %s is not cached", gk)
+	}
+	info.refresh()
+	return nil
+}
+
+// getDiscoverer returns the discoverer of the cluster, which defaults to kubectl
+func (c *clusterInfo) getDiscoverer() Discoverer {
+	if c.discoverer != nil {
+		return c.discoverer
+	}
+	return c.kubectl
+}
+
+// getAPIResources returns the resources which should be cached
+func (c *clusterInfo) getAPIResources(config *rest.Config) ([]kube.APIResourceInfo, error) {
+	apis, err := c.getDiscoverer().GetAPIResources(config, c.cacheSettingsSrc().ResourcesFilter)
+	if err != nil {
+		return nil, err
+	}
+	if c.preferredVersionsOnly {
+		res := make([]kube.APIResourceInfo, 0, len(apis))
+		for i := range apis {
+			if apis[i].PreferredVersion {
+				res = append(res, apis[i])
+			}
+		}
+		apis = res
+	}
+	if len(c.evictedKinds) > 0 {
+		res := make([]kube.APIResourceInfo, 0, len(apis))
+		for i := range apis {
+			if !c.evictedKinds[apis[i].GroupKind] {
+				res = append(res, apis[i])
+			}
+		}
+		apis = res
+	}
+	if len(c.priorityKinds) > 0 {
+		sort.SliceStable(apis, func(i, j int) bool {
+			return c.priorityRank(apis[i].GroupKind) < c.priorityRank(apis[j].GroupKind)
+		})
+	}
+	c.apiWarnings.setKinds(apis)
+	return apis, nil
+}
+
+// priorityRank returns the position of the kind in the priority kinds or the number of priority kinds if the kind is
+// not a priority one
+func (c *clusterInfo) priorityRank(gk schema.GroupKind) int {
+	for i := range c.priorityKinds {
+		if c.priorityKinds[i] == gk {
+			return i
+		}
+	}
+	return len(c.priorityKinds)
+}
+
+func (c *clusterInfo) isPriorityKind(gk schema.GroupKind) bool {
+	return c.priorityRank(gk) < len(c.priorityKinds)
+}
+
+// restConfig returns the cluster REST config which reports warnings returned by the API server
+func (c *clusterInfo) restConfig() *rest.Config {
+	return addWarningsTransportWrapper(c.cluster.RESTConfig(), c.apiWarnings.record)
+}
+
+// listRestConfig returns the REST config of the client used to list resources
+func (c *clusterInfo) listRestConfig() *rest.Config {
+	config := c.restConfig()
+	if c.listConfigTweak != nil {
+		c.listConfigTweak(config)
+	}
+	return config
+}
+
+// watchRestConfig returns the REST config of the client used to watch resources
+func (c *clusterInfo) watchRestConfig() *rest.Config {
+	config := c.restConfig()
+	if c.watchConfigTweak != nil {
+		c.watchConfigTweak(config)
 	}
+	return config
+}
+
+// getDeprecationWarnings returns the latest warning returned by the API server for each kind, such as a warning about
+// deprecated API version which is going to be removed by the next cluster upgrade
+func (c *clusterInfo) getDeprecationWarnings() map[schema.GroupKind]string {
+	return c.apiWarnings.get()
 }
 
 // startMissingWatches lists supported cluster resources and start watching for changes unless watch is already running
 func (c *clusterInfo) startMissingWatches() error {
-	config := c.cluster.RESTConfig()
+	if c.stopped {
+		return nil
+	}
+	config := c.watchRestConfig()
 
-	apis, err := c.kubectl.GetAPIResources(config, c.cacheSettingsSrc().ResourcesFilter)
+	apis, err := c.getAPIResources(config)
 	if err != nil {
 		return err
 	}
+	return c.startWatches(config, apis)
+}
+
+// startWatches starts watches of the given kinds which are not watched yet
+func (c *clusterInfo) startWatches(config *rest.Config, apis []kube.APIResourceInfo) error {
 	client, err := c.kubectl.NewDynamicClient(config)
 	if err != nil {
 		return err
 	}
 
+	if c.namespaceWatchConcurrency > 0 && c.namespaceWatchSlots == nil {
+		c.namespaceWatchSlots = make(chan struct{}, c.namespaceWatchConcurrency)
+	}
+
 	for i := range apis {
 		api := apis[i]
 		if _, ok := c.apisMeta[api.GroupKind]; !ok {
-			ctx, cancel := context.WithCancel(context.Background())
-			info := &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel}
-			c.apisMeta[api.GroupKind] = info
-
-			err = c.processApi(client, api, func(resClient dynamic.ResourceInterface, ns string) error {
-				go c.watchEvents(ctx, api, info, resClient, ns)
-				return nil
-			})
-			if err != nil {
+			if err := c.startWatch(client, api); err != nil {
 				return err
 			}
 		}
@@ -231,101 +1137,832 @@ func (c *clusterInfo) startMissingWatches() error {
 	return nil
 }
 
-func runSynced(lock *sync.Mutex, action func() error) error {
-	lock.Lock()
-	defer lock.Unlock()
-	return action()
+// startWatch starts watching resources of the given kind in every cached namespace
+func (c *clusterInfo) startWatch(client dynamic.Interface, api kube.APIResourceInfo) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	info := &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel, watchCtx: ctx}
+	c.apisMeta[api.GroupKind] = info
+
+	return c.processApi(ctx, client, api, func(resClient dynamic.ResourceInterface, ns string) error {
+		c.startNamespaceWatch(api, info, resClient, ns)
+		return nil
+	})
 }
 
-func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo, info *apiMeta, resClient dynamic.ResourceInterface, ns string) {
-	util.RetryUntilSucceed(func() (err error) {
-		defer func() {
-			if r := recover(); r != nil {
-				err = fmt.Errorf("Recovered from panic: %+v\n%s", r, debug.Stack())
-			}
-		}()
+// startCRDWatch starts watching resources defined by the given established CRD without re-discovering all API resources
+// of the cluster. Returns false if the CRD does not have enough information to start the watch, so the caller should
+// fall back to startMissingWatches.
+func (c *clusterInfo) startCRDWatch(crd *unstructured.Unstructured) (bool, error) {
+	if c.stopped {
+		return true, nil
+	}
+	// preferred version of the group is known to the discovery only
+	if c.preferredVersionsOnly {
+		return false, nil
+	}
+	api, ok := crdAPIResourceInfo(crd)
+	if !ok {
+		return false, nil
+	}
+	if _, ok := c.apisMeta[api.GroupKind]; ok || c.evictedKinds[api.GroupKind] {
+		return true, nil
+	}
+	if filter := c.cacheSettingsSrc().ResourcesFilter; filter != nil && filter.IsExcludedResource(api.GroupKind.Group, api.GroupKind.Kind, c.cluster.Server) {
+		return true, nil
+	}
+	client, err := c.kubectl.NewDynamicClient(c.watchRestConfig())
+	if err != nil {
+		return false, err
+	}
+	return true, c.startWatch(client, api)
+}
 
-		err = runSynced(c.lock, func() error {
-			if info.resourceVersion == "" {
-				list, err := resClient.List(metav1.ListOptions{})
-				if err != nil {
-					return err
-				}
-				c.replaceResourceCache(api.GroupKind, list.GetResourceVersion(), list.Items, ns)
-			}
-			return nil
-		})
+// startNamespaceWatch starts the kind watch of the given namespace, which can be cancelled separately from the watches
+// of other namespaces
+func (c *clusterInfo) startNamespaceWatch(api kube.APIResourceInfo, info *apiMeta, resClient dynamic.ResourceInterface, ns string) {
+	ctx := info.watchCtx
+	if ns != "" {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		if info.namespaceWatchCancels == nil {
+			info.namespaceWatchCancels = make(map[string]context.CancelFunc)
+		}
+		if prevCancel, ok := info.namespaceWatchCancels[ns]; ok {
+			// the namespace watch is restarted, e.g. after failed re-list of the namespace
+			prevCancel()
+		}
+		info.namespaceWatchCancels[ns] = cancel
+	}
+	go c.watchEvents(ctx, api, info, resClient, ns)
+}
+
+// updateNamespaces changes the set of cached namespaces without full cache invalidation: resources of added namespaces
+// are listed and watched, watches of removed namespaces are stopped and their resources are evicted. Switching between
+// cluster level and namespaced cache requires full resync, so the cache is invalidated in this case.
+func (c *clusterInfo) updateNamespaces(namespaces []string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.stopped {
+		return errClusterCacheStopped
+	}
+	cluster := c.cluster.DeepCopy()
+	oldNamespaces := cluster.Namespaces
+	cluster.Namespaces = append([]string{}, namespaces...)
+	c.cluster = cluster
+
+	if !c.synced() || c.apisMeta == nil {
+		// new namespaces are going to be used by the next sync, so the sync in progress listing the old ones is restarted
+		c.interruptSync()
+		return nil
+	}
+	if len(oldNamespaces) == 0 || len(namespaces) == 0 {
+		if len(oldNamespaces) != len(namespaces) {
+			c.syncTime = nil
+			for i := range c.apisMeta {
+				c.apisMeta[i].watchCancel()
+			}
+			c.apisMeta = nil
+		}
+		return nil
+	}
+
+	newSet := make(map[string]bool)
+	for _, ns := range namespaces {
+		newSet[ns] = true
+	}
+	var added []string
+	oldSet := make(map[string]bool)
+	for _, ns := range oldNamespaces {
+		oldSet[ns] = true
+		if !newSet[ns] {
+			c.evictNamespace(ns)
+		}
+	}
+	for _, ns := range namespaces {
+		if !oldSet[ns] {
+			added = append(added, ns)
+		}
+	}
+	if len(added) == 0 {
+		return nil
+	}
+	return c.listNamespaces(added)
+}
+
+// namespaceList holds resources of a single kind listed in a single namespace by listNamespaces
+type namespaceList struct {
+	gk   schema.GroupKind
+	info *apiMeta
+	ns   string
+	opts metav1.ListOptions
+	api  kube.APIResourceInfo
+	list *unstructured.UnstructuredList
+}
+
+// listNamespaces lists resources of the watched kinds in the given namespaces and adds them to the cache. Watches of the
+// namespaces are started unless resources are watched by the cluster level watches. The caller must hold the cluster
+// lock, which is released while resources are listed, so readers and watches are not blocked by the network calls.
+func (c *clusterInfo) listNamespaces(namespaces []string) error {
+	server := c.cluster.Server
+	config := c.listRestConfig()
+	watchConfig := c.watchRestConfig()
+	resourcesFilter := c.cacheSettingsSrc().ResourcesFilter
+	discoverer := c.getDiscoverer()
+	var lists []*namespaceList
+	for gk, info := range c.apisMeta {
+		if !info.namespaced {
+			continue
+		}
+		for _, ns := range namespaces {
+			if !c.isExcludedInNamespace(ns, gk) {
+				lists = append(lists, &namespaceList{gk: gk, info: info, ns: ns, opts: c.listOptions(gk)})
+			}
+		}
+	}
+	if len(lists) == 0 {
+		return nil
+	}
+
+	c.lock.Unlock()
+	err := func() error {
+		apis, err := discoverer.GetAPIResources(config, resourcesFilter)
+		if err != nil {
+			return err
+		}
+		apiByGroupKind := make(map[schema.GroupKind]kube.APIResourceInfo)
+		for i := range apis {
+			apiByGroupKind[apis[i].GroupKind] = apis[i]
+		}
+		client, err := c.kubectl.NewDynamicClient(config)
+		if err != nil {
+			return err
+		}
+		for _, l := range lists {
+			api, ok := apiByGroupKind[l.gk]
+			if !ok {
+				continue
+			}
+			l.api = api
+			l.list, err = c.listKindWithOptions(l.info.watchCtx, server, l.gk, client.Resource(api.GroupVersionResource).Namespace(l.ns), l.opts)
+			if err != nil {
+				if l.info.watchCtx.Err() != nil {
+					// the cache has been invalidated meanwhile, so resources are going to be listed by the next sync
+					l.list = nil
+					continue
+				}
+				return err
+			}
+		}
+		return nil
+	}()
+	c.lock.Lock()
+	if err != nil {
+		return err
+	}
+	if c.stopped {
+		return errClusterCacheStopped
+	}
+
+	watchClient, err := c.kubectl.NewDynamicClient(watchConfig)
+	if err != nil {
+		return err
+	}
+	for _, l := range lists {
+		// the kind might have been evicted or the cache invalidated while resources were listed
+		if l.list == nil || c.apisMeta[l.gk] != l.info || l.info.watchCtx.Err() != nil || !c.isNamespaceCached(l.ns) {
+			continue
+		}
+		for j := range l.list.Items {
+			obj := &l.list.Items[j]
+			key := c.getResourceKey(obj)
+			existingNode, exists := c.nodes[key]
+			c.onNodeUpdated(exists, existingNode, obj, key)
+		}
+		if len(c.cluster.Namespaces) > 0 {
+			c.startNamespaceWatch(l.api, l.info, watchClient.Resource(l.api.GroupVersionResource).Namespace(l.ns), l.ns)
+		}
+	}
+	return nil
+}
+
+// isNamespaceCached returns true if resources of the given namespace are cached
+func (c *clusterInfo) isNamespaceCached(ns string) bool {
+	if len(c.cluster.Namespaces) == 0 {
+		return true
+	}
+	for i := range c.cluster.Namespaces {
+		if c.cluster.Namespaces[i] == ns {
+			return true
+		}
+	}
+	return false
+}
+
+// invalidateNamespace evicts resources of the given namespace from the cache. Resources of the namespace are re-listed
+// by the next ensureSynced call without full cluster sync. Cluster level resources are not affected.
+func (c *clusterInfo) invalidateNamespace(ns string) error {
+	if ns == "" {
+		return fmt.Errorf("namespace must be specified")
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.stopped {
+		return errClusterCacheStopped
+	}
+	if !c.synced() || c.apisMeta == nil {
+		// all resources are going to be listed by the next sync
+		return nil
+	}
+	if !c.isNamespaceCached(ns) {
+		return nil
+	}
+	c.evictNamespace(ns)
+	if c.invalidatedNamespaces == nil {
+		c.invalidatedNamespaces = make(map[string]bool)
+	}
+	c.invalidatedNamespaces[ns] = true
+	return nil
+}
+
+// relistInvalidatedNamespaces lists resources of the namespaces invalidated since the last sync
+func (c *clusterInfo) relistInvalidatedNamespaces() error {
+	namespaces := make([]string, 0, len(c.invalidatedNamespaces))
+	for ns := range c.invalidatedNamespaces {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	// namespaces invalidated while the lock is released by listNamespaces are re-listed by the next call
+	c.invalidatedNamespaces = nil
+	if err := c.listNamespaces(namespaces); err != nil {
+		if c.invalidatedNamespaces == nil {
+			c.invalidatedNamespaces = make(map[string]bool)
+		}
+		for _, ns := range namespaces {
+			c.invalidatedNamespaces[ns] = true
+		}
+		return err
+	}
+	return nil
+}
+
+// evictNamespace stops watches of the given namespace and removes its resources from the cache
+func (c *clusterInfo) evictNamespace(ns string) {
+	for _, info := range c.apisMeta {
+		if cancel, ok := info.namespaceWatchCancels[ns]; ok {
+			cancel()
+			delete(info.namespaceWatchCancels, ns)
+		}
+		info.resetResourceVersion(ns)
+		delete(info.refreshChs, ns)
+	}
+	for key, n := range c.nsIndex[ns] {
+		c.onNodeRemoved(key, n)
+	}
+}
+
+// retryStartMissingWatches keeps trying to start missing watches with exponential backoff, so that a transient failure
+// (e.g. failure to build the dynamic client) does not leave newly added kinds unwatched until the next full sync.
+// If expected kinds are specified then retry continues until all of them are discovered and watched.
+func (c *clusterInfo) retryStartMissingWatches(ctx context.Context, expected ...schema.GroupKind) {
+	err := wait.ExponentialBackoff(startMissingWatchesBackoff, func() (bool, error) {
+		err := runSynced(c.lock, func() error {
+			// cluster cache has been invalidated, watches are going to be started by the next sync
+			if ctx.Err() != nil {
+				return nil
+			}
+			if err := c.startMissingWatches(); err != nil {
+				return err
+			}
+			for _, gk := range expected {
+				if _, ok := c.apisMeta[gk]; !ok {
+					return fmt.Errorf("kind %s is not discoverable yet", gk)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			log.Warnf("Failed to start missing watches on %s: %v", c.cluster.Server, err)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		log.Errorf("Gave up starting missing watches on %s: %v", c.cluster.Server, err)
+	}
+}
+
+// crdGroupKind returns group and kind of the resources defined by the given CRD
+func crdGroupKind(crd *unstructured.Unstructured) (schema.GroupKind, bool) {
+	group, groupOk, groupErr := unstructured.NestedString(crd.Object, "spec", "group")
+	kind, kindOk, kindErr := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+	if !groupOk || groupErr != nil || !kindOk || kindErr != nil {
+		return schema.GroupKind{}, false
+	}
+	return schema.GroupKind{Group: group, Kind: kind}, true
+}
+
+// crdAPIResourceInfo returns the API resource of the resources defined by the given CRD. Resources of multi-version CRD
+// are served using the storage version if it is served, or the first served version otherwise.
+func crdAPIResourceInfo(crd *unstructured.Unstructured) (kube.APIResourceInfo, bool) {
+	gk, ok := crdGroupKind(crd)
+	if !ok {
+		return kube.APIResourceInfo{}, false
+	}
+	plural, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "plural")
+	scope, _, _ := unstructured.NestedString(crd.Object, "spec", "scope")
+	if gk.Kind == "" || plural == "" || scope == "" {
+		return kube.APIResourceInfo{}, false
+	}
+	version := ""
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for i := range versions {
+		v, ok := versions[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(v, "name")
+		served, _, _ := unstructured.NestedBool(v, "served")
+		storage, _, _ := unstructured.NestedBool(v, "storage")
+		if name == "" || !served {
+			continue
+		}
+		if version == "" || storage {
+			version = name
+		}
+	}
+	if len(versions) == 0 {
+		// single version CRD
+		version, _, _ = unstructured.NestedString(crd.Object, "spec", "version")
+	}
+	if version == "" {
+		return kube.APIResourceInfo{}, false
+	}
+	return kube.APIResourceInfo{
+		GroupKind: gk,
+		Meta: metav1.APIResource{
+			Name:       plural,
+			Group:      gk.Group,
+			Version:    version,
+			Kind:       gk.Kind,
+			Namespaced: scope == "Namespaced",
+		},
+		GroupVersionResource: schema.GroupVersionResource{Group: gk.Group, Version: version, Resource: plural},
+	}, true
+}
+
+// isCRDEstablished returns true if the given CRD has the Established condition, which means the CRD resources are served
+func isCRDEstablished(crd *unstructured.Unstructured) bool {
+	conditions, ok, err := unstructured.NestedSlice(crd.Object, "status", "conditions")
+	if !ok || err != nil {
+		return false
+	}
+	for i := range conditions {
+		condition, ok := conditions[i].(map[string]interface{})
+		if ok && condition["type"] == "Established" && condition["status"] == "True" {
+			return true
+		}
+	}
+	return false
+}
+
+func runSynced(lock *sync.RWMutex, action func() error) error {
+	lock.Lock()
+	defer lock.Unlock()
+	return action()
+}
+
+// watchRetryDelay returns the delay before the next watch retry after the given number of consecutive failures. The delay
+// grows exponentially starting from the backoff duration and never exceeds the backoff cap if the cap is specified.
+// Non-positive duration means watchResourcesRetryTimeout and non-positive factor means constant delay. The delay is never
+// shorter than minWatchRetryDelay.
+func watchRetryDelay(backoff wait.Backoff, failures int) time.Duration {
+	if backoff.Duration <= 0 {
+		backoff.Duration = watchResourcesRetryTimeout
+	}
+	if backoff.Factor <= 0 {
+		backoff.Factor = 1
+	}
+	delay := float64(backoff.Duration) * math.Pow(backoff.Factor, float64(failures-1))
+	if backoff.Cap > 0 && delay > float64(backoff.Cap) {
+		// avoid overflow of the duration after many failures
+		delay = float64(backoff.Cap)
+	}
+	res := time.Duration(delay)
+	if backoff.Jitter > 0 {
+		res = wait.Jitter(res, backoff.Jitter)
+	}
+	if backoff.Cap > 0 && res > backoff.Cap {
+		res = backoff.Cap
+	}
+	if res < minWatchRetryDelay {
+		res = minWatchRetryDelay
+	}
+	return res
+}
+
+// acquireNamespaceWatchSlot waits until the watch of the given namespace is allowed to be established and returns the
+// function which releases the slot. Cluster level watches are not limited.
+func (c *clusterInfo) acquireNamespaceWatchSlot(ctx context.Context, ns string) (func(), error) {
+	c.lock.RLock()
+	slots := c.namespaceWatchSlots
+	c.lock.RUnlock()
+	if ns == "" || slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			<-slots
+		})
+	}, nil
+}
+
+func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo, info *apiMeta, resClient dynamic.ResourceInterface, ns string) {
+	// number of consecutive failures since the watch has been established last time
+	failures := 0
+	// restartRequested is true if the latest watch has been stopped to re-list the kind rather than failed
+	restartRequested := false
+	util.RetryUntilSucceedWithDelay(func() (err error) {
+		defer func() {
+			restartRequested = err == errResyncPeriodElapsed || err == errRefreshRequested
+			if err != nil && !restartRequested {
+				failures++
+				c.recordWatchFailure(api.GroupKind, err)
+				if c.metricsRecorder != nil {
+					c.metricsRecorder.OnWatchReconnect(api.GroupKind)
+				}
+			}
+		}()
+		defer func() {
+			if r := recover(); r != nil {
+				stack := debug.Stack()
+				err = fmt.Errorf("recovered from panic: %+v\n%s", r, stack)
+				if c.onPanic != nil {
+					c.onPanic(api.GroupKind, r, stack)
+				}
+			}
+		}()
+
+		releaseSlot, err := c.acquireNamespaceWatchSlot(ctx, ns)
+		if err != nil {
+			// watch has been cancelled while waiting for the slot
+			return nil
+		}
+		defer releaseSlot()
+
+		var refreshCh <-chan struct{}
+		var resourceVersion string
+		var resyncPeriod time.Duration
+		var rateLimiter flowcontrol.RateLimiter
+		err = runSynced(c.lock, func() error {
+			if c.stopped {
+				return nil
+			}
+			refreshCh = info.refreshCh(ns)
+			resyncPeriod = c.resyncPeriodOverrides[api.GroupKind]
+			rateLimiter = c.watchEstablishRateLimiter
+			if info.resourceVersion(ns) == "" {
+				list, err := c.listKind(ctx, api.GroupKind, resClient)
+				if err != nil {
+					return err
+				}
+				c.replaceResourceCache(api.GroupKind, list.GetResourceVersion(), list.Items, ns)
+				info.listed = true
+			}
+			resourceVersion = info.resourceVersion(ns)
+			return nil
+		})
 
 		if err != nil {
 			return err
 		}
 
-		w, err := resClient.Watch(metav1.ListOptions{ResourceVersion: info.resourceVersion})
+		watchOpts := c.listOptions(api.GroupKind)
+		watchOpts.Limit = 0
+		watchOpts.ResourceVersion = resourceVersion
+		watchOpts.AllowWatchBookmarks = c.watchBookmarks
+		if rateLimiter != nil {
+			if err := rateLimiter.Wait(ctx); err != nil {
+				// watch has been cancelled while waiting for the rate limiter
+				return nil
+			}
+		}
+		span := tracing.StartSpan("WatchResources")
+		span.SetBaggageItem("server", c.cluster.Server)
+		span.SetBaggageItem("group_kind", api.GroupKind.String())
+		span.SetBaggageItem("namespace", ns)
+		w, err := resClient.Watch(watchOpts)
+		span.Finish()
+		releaseSlot()
 		if errors.IsNotFound(err) {
-			c.stopWatching(api.GroupKind, ns)
+			c.stopWatching(api.GroupKind, ns, WatchStopReasonNotFound)
 			return nil
 		}
+		if errors.IsBadRequest(err) && watchOpts.FieldSelector != "" {
+			c.disableFieldSelector(api.GroupKind, err)
+		}
 
 		err = runSynced(c.lock, func() error {
 			if errors.IsGone(err) {
-				info.resourceVersion = ""
+				info.resetResourceVersion(ns)
 				log.Warnf("Resource version of %s on %s is too old.", api.GroupKind, c.cluster.Server)
 			}
+			if errors.IsBadRequest(err) && watchOpts.FieldSelector != "" {
+				// re-list resources without the field selector
+				info.resetResourceVersion(ns)
+			}
+			if err == nil {
+				info.watching = true
+				failures = 0
+			}
 			return err
 		})
 
 		if err != nil {
 			return err
 		}
-		defer w.Stop()
+		defer func() {
+			w.Stop()
+			c.lock.Lock()
+			info.watching = false
+			c.lock.Unlock()
+		}()
+
+		var resyncCh <-chan time.Time
+		if resyncPeriod > 0 {
+			resyncTimer := time.NewTimer(resyncPeriod)
+			defer resyncTimer.Stop()
+			resyncCh = resyncTimer.C
+		}
+
 		for {
 			select {
 			case <-ctx.Done():
 				return nil
+			case <-resyncCh:
+				_ = runSynced(c.lock, func() error {
+					info.resetResourceVersion(ns)
+					return nil
+				})
+				return errResyncPeriodElapsed
+			case <-refreshCh:
+				return errRefreshRequested
 			case event, ok := <-w.ResultChan():
 				if ok {
+					if ctx.Err() != nil {
+						// watch of the namespace has been stopped, so the event must not add resources back to the cache
+						return nil
+					}
+					if event.Type == watch.Error {
+						// error event carries the status of the failed watch instead of a resource
+						return c.handleWatchError(api.GroupKind, info, ns, event.Object)
+					}
 					obj := event.Object.(*unstructured.Unstructured)
-					info.resourceVersion = obj.GetResourceVersion()
+					if event.Type == watch.Bookmark {
+						// bookmark only advances the resource version and carries no resource changes
+						c.recordBookmark(info, ns, obj.GetResourceVersion())
+						continue
+					}
+					c.setResourceVersion(info, ns, obj.GetResourceVersion())
+					if c.trackWatchBytes {
+						c.recordWatchBytes(api.GroupKind, obj)
+					}
 					c.processEvent(event.Type, obj)
 					if kube.IsCRD(obj) {
+						// watch of CRD resources is started only after CRD is established: resources of not established
+						// CRD are not served yet
+						gk, gkOk := crdGroupKind(obj)
 						if event.Type == watch.Deleted {
-							group, groupOk, groupErr := unstructured.NestedString(obj.Object, "spec", "group")
-							kind, kindOk, kindErr := unstructured.NestedString(obj.Object, "spec", "names", "kind")
-
-							if groupOk && groupErr == nil && kindOk && kindErr == nil {
-								gk := schema.GroupKind{Group: group, Kind: kind}
-								c.stopWatching(gk, ns)
+							if gkOk {
+								c.stopWatching(gk, ns, WatchStopReasonCRDDeleted)
 							}
-						} else {
+						} else if isCRDEstablished(obj) {
+							// CRD resources might be not discoverable right after CRD is established, so retry until
+							// the kind is watched
+							var expected []schema.GroupKind
 							err = runSynced(c.lock, func() error {
-								return c.startMissingWatches()
+								if started, err := c.startCRDWatch(obj); started || err != nil {
+									return err
+								}
+								if err := c.startMissingWatches(); err != nil {
+									return err
+								}
+								if _, ok := c.apisMeta[gk]; gkOk && !ok {
+									expected = append(expected, gk)
+									return fmt.Errorf("kind %s is not discoverable yet", gk)
+								}
+								return nil
 							})
-
+							if err != nil {
+								log.Warnf("Failed to start missing watch: %v", err)
+								go c.retryStartMissingWatches(ctx, expected...)
+								err = nil
+							}
 						}
 					}
-					if err != nil {
-						log.Warnf("Failed to start missing watch: %v", err)
-					}
 				} else {
 					return fmt.Errorf("Watch %s on %s has closed", api.GroupKind, c.cluster.Server)
 				}
 			}
 		}
 
-	}, fmt.Sprintf("watch %s on %s", api.GroupKind, c.cluster.Server), ctx, watchResourcesRetryTimeout)
+	}, fmt.Sprintf("watch %s on %s", api.GroupKind, c.cluster.Server), ctx, func() time.Duration {
+		if restartRequested {
+			return 0
+		}
+		c.lock.RLock()
+		backoff := c.watchRetryBackoff
+		c.lock.RUnlock()
+		if backoff == nil {
+			return watchResourcesRetryTimeout
+		}
+		return watchRetryDelay(*backoff, failures)
+	})
+}
+
+// handleWatchError converts the object of the watch error event to an error. The resource version is reset if it is
+// too old, so the kind is re-listed in the namespace when the watch is restarted.
+func (c *clusterInfo) handleWatchError(gk schema.GroupKind, info *apiMeta, ns string, obj runtime.Object) error {
+	err := errors.FromObject(obj)
+	log.Warnf("Watch %s on %s has failed: %v", gk, c.cluster.Server, err)
+	if errors.IsGone(err) || errors.IsResourceExpired(err) {
+		_ = runSynced(c.lock, func() error {
+			info.resetResourceVersion(ns)
+			return nil
+		})
+		log.Warnf("Resource version of %s on %s is too old.", gk, c.cluster.Server)
+	}
+	return err
+}
+
+// setResourceVersion records the resource version of the latest event received by the kind watch of the namespace
+func (c *clusterInfo) setResourceVersion(info *apiMeta, ns string, resourceVersion string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	info.setResourceVersion(ns, resourceVersion)
+}
+
+// recordBookmark records the resource version and the time of the bookmark received by the kind watch of the given
+// namespace
+func (c *clusterInfo) recordBookmark(info *apiMeta, ns string, resourceVersion string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	info.setResourceVersion(ns, resourceVersion)
+	if info.bookmarkTimes == nil {
+		info.bookmarkTimes = make(map[string]time.Time)
+	}
+	info.bookmarkTimes[ns] = time.Now()
+}
+
+func (c *clusterInfo) recordWatchBytes(gk schema.GroupKind, obj *unstructured.Unstructured) {
+	data, err := obj.MarshalJSON()
+	if err != nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.watchBytesReceived == nil {
+		c.watchBytesReceived = make(map[schema.GroupKind]int64)
+	}
+	c.watchBytesReceived[gk] += int64(len(data))
+}
+
+// getWatchBytesReceived returns the estimated number of bytes received by the watches of each kind
+func (c *clusterInfo) getWatchBytesReceived() map[schema.GroupKind]int64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	res := make(map[schema.GroupKind]int64, len(c.watchBytesReceived))
+	for gk, bytes := range c.watchBytesReceived {
+		res[gk] = bytes
+	}
+	return res
+}
+
+func (c *clusterInfo) recordWatchFailure(gk schema.GroupKind, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.watchStatus == nil {
+		c.watchStatus = make(map[schema.GroupKind]*KindWatchStatus)
+	}
+	status, ok := c.watchStatus[gk]
+	if !ok {
+		status = &KindWatchStatus{GroupKind: gk}
+		c.watchStatus[gk] = status
+	}
+	now := time.Now()
+	status.Forbidden = errors.IsForbidden(err)
+	status.LastError = err.Error()
+	status.LastErrorTime = &now
+	status.Restarts++
+}
+
+// isWatchHealthy returns true if the watch of the given kind is established and has not failed recently
+func (c *clusterInfo) isWatchHealthy(gk schema.GroupKind) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	info, ok := c.apisMeta[gk]
+	if !ok || !info.watching {
+		return false
+	}
+	if status, ok := c.watchStatus[gk]; ok && status.LastErrorTime != nil {
+		return time.Since(*status.LastErrorTime) > unhealthyWatchPeriod
+	}
+	return true
+}
+
+// getWatchStatus returns watch failures history of every kind which watch has ever failed, sorted by group and kind
+func (c *clusterInfo) getWatchStatus() []KindWatchStatus {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	res := make([]KindWatchStatus, 0, len(c.watchStatus))
+	for _, status := range c.watchStatus {
+		res = append(res, *status)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		gk1, gk2 := res[i].GroupKind, res[j].GroupKind
+		if gk1.Group != gk2.Group {
+			return gk1.Group < gk2.Group
+		}
+		return gk1.Kind < gk2.Kind
+	})
+	return res
+}
+
+// getKindStatuses returns the state of caching of every kind known to the cache
+func (c *clusterInfo) getKindStatuses() map[schema.GroupKind]KindStatus {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	res := make(map[schema.GroupKind]KindStatus, len(c.apisMeta))
+	for gk, info := range c.apisMeta {
+		status := KindStatus{
+			Listed:           info.listed,
+			Watching:         info.watching,
+			ResourceVersion:  info.resourceVersion(""),
+			ResourceVersions: info.namespaceResourceVersions(),
+		}
+		if watchStatus, ok := c.watchStatus[gk]; ok && watchStatus.LastError != "" {
+			status.LastError = watchStatus.LastError
+		} else if err, ok := c.syncErrors[gk]; ok {
+			status.LastError = err.Error()
+		}
+		res[gk] = status
+	}
+	return res
 }
 
-func (c *clusterInfo) processApi(client dynamic.Interface, api kube.APIResourceInfo, callback func(resClient dynamic.ResourceInterface, ns string) error) error {
+// exportWatchStatus serializes watch failures history so it can be restored by importWatchStatus after restart
+func (c *clusterInfo) exportWatchStatus() ([]byte, error) {
+	return json.Marshal(c.getWatchStatus())
+}
+
+// importWatchStatus restores watch failures history exported by exportWatchStatus. Restored entries are merged with the
+// history collected since start.
+func (c *clusterInfo) importWatchStatus(data []byte) error {
+	var statuses []KindWatchStatus
+	if err := json.Unmarshal(data, &statuses); err != nil {
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.watchStatus == nil {
+		c.watchStatus = make(map[schema.GroupKind]*KindWatchStatus)
+	}
+	for i := range statuses {
+		imported := statuses[i]
+		if status, ok := c.watchStatus[imported.GroupKind]; ok {
+			status.Restarts += imported.Restarts
+		} else {
+			c.watchStatus[imported.GroupKind] = &imported
+		}
+	}
+	return nil
+}
+
+func (c *clusterInfo) processApi(ctx context.Context, client dynamic.Interface, api kube.APIResourceInfo, callback func(resClient dynamic.ResourceInterface, ns string) error) error {
 	resClient := client.Resource(api.GroupVersionResource)
 	if len(c.cluster.Namespaces) == 0 {
 		return callback(resClient, "")
 	}
 
 	if !api.Meta.Namespaced {
+		if c.isIncludedClusterScopedKind(api.GroupKind) {
+			return callback(resClient, "")
+		}
 		return nil
 	}
 
 	for _, ns := range c.cluster.Namespaces {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if c.isExcludedInNamespace(ns, api.GroupKind) {
+			continue
+		}
 		err := callback(resClient.Namespace(ns), ns)
 		if err != nil {
 			return err
@@ -334,7 +1971,179 @@ func (c *clusterInfo) processApi(client dynamic.Interface, api kube.APIResourceI
 	return nil
 }
 
-func (c *clusterInfo) sync() (err error) {
+// isIncludedClusterScopedKind returns true if resources of the given cluster level kind should be cached even if the
+// cache is limited to the specific namespaces
+func (c *clusterInfo) isIncludedClusterScopedKind(gk schema.GroupKind) bool {
+	for i := range c.includeClusterScopedKinds {
+		if c.includeClusterScopedKinds[i] == gk {
+			return true
+		}
+	}
+	return false
+}
+
+// isExcludedInNamespace returns true if resources of the given kind should not be cached in the given namespace
+func (c *clusterInfo) isExcludedInNamespace(ns string, gk schema.GroupKind) bool {
+	return c.namespaceResourceFilter != nil && c.namespaceResourceFilter(ns, gk)
+}
+
+// listResources lists resources using the given client. The client does not support cancellation, so the function
+// returns the context error as soon as the context is done without waiting for the list to complete.
+func listResources(ctx context.Context, resClient dynamic.ResourceInterface, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	type listResult struct {
+		list *unstructured.UnstructuredList
+		err  error
+	}
+	resultCh := make(chan listResult, 1)
+	go func() {
+		list, err := resClient.List(opts)
+		resultCh <- listResult{list: list, err: err}
+	}()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-resultCh:
+		return res.list, res.err
+	}
+}
+
+// listOptions returns options used to list and watch cached resources
+func (c *clusterInfo) listOptions(gk schema.GroupKind) metav1.ListOptions {
+	opts := metav1.ListOptions{Limit: c.listPageSize, FieldSelector: c.fieldSelector(gk)}
+	if c.resourceLabelSelector != nil && !c.resourceLabelSelector.Empty() {
+		opts.LabelSelector = c.resourceLabelSelector.String()
+	}
+	return opts
+}
+
+// fieldSelector returns the field selector of the specified kind or empty string if the kind has no selector or the
+// selector has been rejected by the API server
+func (c *clusterInfo) fieldSelector(gk schema.GroupKind) string {
+	c.fieldSelectorsLock.Lock()
+	defer c.fieldSelectorsLock.Unlock()
+	if c.disabledFieldSelectors[gk] {
+		return ""
+	}
+	return c.fieldSelectors[gk]
+}
+
+// disableFieldSelector makes the cache ignore the field selector of the specified kind
+func (c *clusterInfo) disableFieldSelector(gk schema.GroupKind, err error) {
+	c.fieldSelectorsLock.Lock()
+	defer c.fieldSelectorsLock.Unlock()
+	if c.disabledFieldSelectors == nil {
+		c.disabledFieldSelectors = make(map[schema.GroupKind]bool)
+	}
+	c.disabledFieldSelectors[gk] = true
+	c.log.Warnf("Field selector '%s' of %s is not supported, falling back to no field selector: %v", c.fieldSelectors[gk], gk, err)
+}
+
+// setFieldSelectors replaces the field selectors of the kinds. Selectors previously rejected by the API server are tried
+// again.
+func (c *clusterInfo) setFieldSelectors(selectors map[schema.GroupKind]string) {
+	c.fieldSelectorsLock.Lock()
+	defer c.fieldSelectorsLock.Unlock()
+	c.fieldSelectors = selectors
+	c.disabledFieldSelectors = nil
+}
+
+// listKind lists resources of the specified kind and records the list duration
+func (c *clusterInfo) listKind(ctx context.Context, gk schema.GroupKind, resClient dynamic.ResourceInterface) (*unstructured.UnstructuredList, error) {
+	return c.listKindWithOptions(ctx, c.cluster.Server, gk, resClient, c.listOptions(gk))
+}
+
+// listKindWithOptions lists resources of the specified kind using the given options and records the list duration. It
+// doesn't access the cached state, so it can be called without holding the cluster lock.
+func (c *clusterInfo) listKindWithOptions(ctx context.Context, server string, gk schema.GroupKind, resClient dynamic.ResourceInterface, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	span := tracing.StartSpan("ListResources")
+	span.SetBaggageItem("server", server)
+	span.SetBaggageItem("group_kind", gk.String())
+	defer span.Finish()
+	start := time.Now()
+	if c.minResourceVersion != "" {
+		list, err := c.listNotOlderThan(ctx, resClient, opts)
+		if err == nil {
+			if c.metricsRecorder != nil {
+				c.metricsRecorder.OnListDuration(gk, time.Since(start))
+			}
+			return list, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		c.log.Warnf("Failed to list %s not older than resource version %s, retrying with default consistency: %v", gk, c.minResourceVersion, err)
+	}
+	list, err := listAllPages(ctx, resClient, opts)
+	if errors.IsBadRequest(err) && opts.FieldSelector != "" {
+		c.disableFieldSelector(gk, err)
+		opts.FieldSelector = ""
+		list, err = listAllPages(ctx, resClient, opts)
+	}
+	if err == nil && c.metricsRecorder != nil {
+		c.metricsRecorder.OnListDuration(gk, time.Since(start))
+	}
+	return list, err
+}
+
+// listNotOlderThan lists resources which state is not older than the minimal resource version. The API versions used by
+// the cache don't support the resource version match option, so the list relies on the legacy semantics of the list
+// with the resource version, which is served by the API server watch cache, and can't be paginated.
+func (c *clusterInfo) listNotOlderThan(ctx context.Context, resClient dynamic.ResourceInterface, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	opts.ResourceVersion = c.minResourceVersion
+	opts.Limit = 0
+	opts.Continue = ""
+	return listResources(ctx, resClient, opts)
+}
+
+// listAllPages lists resources page by page if page size limit is specified and returns resources of all pages along with
+// the resource version of the last page. The list is restarted from scratch if the continue token expires.
+func listAllPages(ctx context.Context, resClient dynamic.ResourceInterface, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	if opts.Limit <= 0 {
+		return listResources(ctx, resClient, opts)
+	}
+	res := &unstructured.UnstructuredList{}
+	for {
+		page, err := listResources(ctx, resClient, opts)
+		if errors.IsResourceExpired(err) && opts.Continue != "" {
+			res = &unstructured.UnstructuredList{}
+			opts.Continue = ""
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		res.Items = append(res.Items, page.Items...)
+		res.SetResourceVersion(page.GetResourceVersion())
+		if page.GetContinue() == "" {
+			return res, nil
+		}
+		opts.Continue = page.GetContinue()
+	}
+}
+
+// checkConnectivity fails fast if the cluster is not reachable, before starting expensive discovery and listing of
+// the cluster resources. Returns the server version retrieved by the check. If the API server responds with an error,
+// e.g. the version endpoint is forbidden in restricted clusters, then the cluster is reachable and the version error is
+// returned separately.
+func (c *clusterInfo) checkConnectivity(config *rest.Config) (version string, versionErr error, err error) {
+	config = rest.CopyConfig(config)
+	config.Timeout = clusterConnectivityTimeout
+	version, err = c.getDiscoverer().GetServerVersion(config)
+	if _, ok := err.(errors.APIStatus); ok {
+		return "", err, nil
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %v", ErrClusterUnreachable, err)
+	}
+	return version, nil, nil
+}
+
+func (c *clusterInfo) sync(ctx context.Context) (err error) {
+	atomic.StoreInt32(&c.syncing, 1)
+	defer atomic.StoreInt32(&c.syncing, 0)
+	span := tracing.StartSpan("SyncCluster")
+	span.SetBaggageItem("server", c.cluster.Server)
+	defer span.Finish()
 
 	c.log.Info("Start syncing cluster")
 
@@ -342,14 +2151,29 @@ func (c *clusterInfo) sync() (err error) {
 		c.apisMeta[i].watchCancel()
 	}
 	c.apisMeta = make(map[schema.GroupKind]*apiMeta)
+	prevNodes := c.nodes
+	if !c.preserveNodeInfo {
+		prevNodes = nil
+	}
 	c.nodes = make(map[kube.ResourceKey]*node)
-	config := c.cluster.RESTConfig()
-	version, err := c.kubectl.GetServerVersion(config)
+	c.unresolvedChildren = nil
+	c.overLimit = false
+	c.changedKeys = make(map[kube.ResourceKey]uint64)
+	c.changesResetGeneration = c.generation
+	config := c.listRestConfig()
+	version, versionErr, err := c.checkConnectivity(config)
 	if err != nil {
 		return err
 	}
-	c.serverVersion = version
-	apis, err := c.kubectl.GetAPIResources(config, c.cacheSettingsSrc().ResourcesFilter)
+	if versionErr != nil {
+		c.log.Warnf("Failed to get server version, keeping the previous version '%s': %v", c.serverVersion, versionErr)
+	} else {
+		c.serverVersion = version
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	apis, err := c.getAPIResources(config)
 	if err != nil {
 		return err
 	}
@@ -357,65 +2181,570 @@ func (c *clusterInfo) sync() (err error) {
 	if err != nil {
 		return err
 	}
+	c.syncErrors = make(map[schema.GroupKind]error)
+	if versionErr != nil {
+		c.syncErrors[ServerVersionGroupKind] = versionErr
+	}
+	// priority kinds are sorted before the rest of the kinds
+	priorityCount := 0
+	for priorityCount < len(apis) && c.isPriorityKind(apis[priorityCount].GroupKind) {
+		priorityCount++
+	}
 	lock := sync.Mutex{}
-	err = util.RunAllAsync(len(apis), func(i int) error {
-		return c.processApi(client, apis[i], func(resClient dynamic.ResourceInterface, _ string) error {
-			list, err := resClient.List(metav1.ListOptions{})
+	slots := c.newListSlots()
+	listApi := func(api kube.APIResourceInfo) error {
+		err := c.processApi(ctx, client, api, func(resClient dynamic.ResourceInterface, _ string) error {
+			releaseListSlot := slots.acquire()
+			list, err := c.listKind(ctx, api.GroupKind, resClient)
+			releaseListSlot()
 			if err != nil {
 				return err
 			}
 
 			lock.Lock()
 			for i := range list.Items {
-				c.setNode(c.createObjInfo(&list.Items[i], c.cacheSettingsSrc().AppInstanceLabelKey))
+				un := &list.Items[i]
+				c.setNode(c.createObjInfoFromPrevious(un, c.cacheSettingsSrc().AppInstanceLabelKey, prevNodes[c.getResourceKey(un)]))
 			}
 			lock.Unlock()
 			return nil
 		})
+		// failure of one API (e.g. unavailable aggregated API) should not prevent caching the rest of the cluster
+		if err != nil {
+			lock.Lock()
+			c.syncErrors[api.GroupKind] = err
+			lock.Unlock()
+		}
+		return nil
+	}
+	lockedApis := apis
+	if priorityCount > 0 && priorityCount < len(apis) {
+		// priority kinds are published once listed and the rest of the kinds are listed without holding the lock
+		lockedApis = apis[:priorityCount]
+	}
+	err = util.RunAllAsync(len(lockedApis), func(i int) error {
+		return listApi(lockedApis[i])
+	})
+	if err == nil && len(lockedApis) < len(apis) && ctx.Err() == nil {
+		err = c.publishKinds(lockedApis)
+		if err == nil {
+			err = c.listKindsUnlocked(ctx, client, apis[len(lockedApis):], prevNodes)
+		}
+	}
+
+	if err == nil {
+		err = ctx.Err()
+	}
+
+	failedApis := 0
+	for i := range apis {
+		if _, failed := c.syncErrors[apis[i].GroupKind]; failed {
+			failedApis++
+		}
+	}
+	if err == nil && len(apis) > 0 && failedApis == len(apis) {
+		err = aggregateSyncErrors(c.syncErrors)
+	}
+
+	for gk, syncErr := range c.syncErrors {
+		c.log.Warnf("Failed to sync %s: %v", gk, syncErr)
+	}
+
+	if err == nil {
+		err = c.startMissingWatches()
+	}
+	for gk, info := range c.apisMeta {
+		if _, failed := c.syncErrors[gk]; !failed {
+			info.listed = true
+		}
+	}
+
+	if err != nil {
+		log.Errorf("Failed to sync cluster %s: %v", c.cluster.Server, err)
+		return err
+	}
+
+	c.log.Info("Cluster successfully synced")
+	return nil
+}
+
+// publishKinds makes resources of the given listed kinds available to the readers of these kinds before the sync
+// completes and starts watching them, so the published resources are kept up to date while the rest of the kinds are
+// listed. Kinds which failed to sync are not published.
+func (c *clusterInfo) publishKinds(apis []kube.APIResourceInfo) error {
+	var published []kube.APIResourceInfo
+	for i := range apis {
+		if _, failed := c.syncErrors[apis[i].GroupKind]; !failed {
+			published = append(published, apis[i])
+		}
+	}
+	if err := c.startWatches(c.watchRestConfig(), published); err != nil {
+		return err
+	}
+	for i := range published {
+		gk := published[i].GroupKind
+		if info, ok := c.apisMeta[gk]; ok {
+			info.listed = true
+		}
+		c.syncedKinds[c.normalizeGroupKind(gk)] = true
+	}
+	return nil
+}
+
+// syncList is a list of resources of a single kind in a single namespace performed by listKindsUnlocked
+type syncList struct {
+	gk        schema.GroupKind
+	resClient dynamic.ResourceInterface
+	opts      metav1.ListOptions
+	list      *unstructured.UnstructuredList
+	err       error
+}
+
+// listKindsUnlocked lists resources of the given kinds and adds them to the cache. The caller must hold the cluster lock,
+// which is released while resources are listed, so the kinds published by the sync can be read in the meantime.
+func (c *clusterInfo) listKindsUnlocked(ctx context.Context, client dynamic.Interface, apis []kube.APIResourceInfo, prevNodes map[kube.ResourceKey]*node) error {
+	var lists []*syncList
+	for i := range apis {
+		gk := apis[i].GroupKind
+		opts := c.listOptions(gk)
+		err := c.processApi(ctx, client, apis[i], func(resClient dynamic.ResourceInterface, _ string) error {
+			lists = append(lists, &syncList{gk: gk, resClient: resClient, opts: opts})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	server := c.cluster.Server
+	slots := c.newListSlots()
+
+	c.lock.Unlock()
+	_ = util.RunAllAsync(len(lists), func(i int) error {
+		l := lists[i]
+		releaseListSlot := slots.acquire()
+		l.list, l.err = c.listKindWithOptions(ctx, server, l.gk, l.resClient, l.opts)
+		releaseListSlot()
+		return nil
+	})
+	c.lock.Lock()
+
+	if c.stopped {
+		return errClusterCacheStopped
+	}
+	if ctx.Err() != nil {
+		// the cache has been invalidated meanwhile or the sync has been cancelled
+		return ctx.Err()
+	}
+	appInstanceLabelKey := c.cacheSettingsSrc().AppInstanceLabelKey
+	for _, l := range lists {
+		// failure of one API (e.g. unavailable aggregated API) should not prevent caching the rest of the cluster
+		if l.err != nil {
+			if _, failed := c.syncErrors[l.gk]; !failed {
+				c.syncErrors[l.gk] = l.err
+			}
+			continue
+		}
+		for i := range l.list.Items {
+			un := &l.list.Items[i]
+			c.setNode(c.createObjInfoFromPrevious(un, appInstanceLabelKey, prevNodes[c.getResourceKey(un)]))
+		}
+	}
+	return nil
+}
+
+// listSlots limits the number of resource lists performed simultaneously by the sync
+type listSlots struct {
+	cond *sync.Cond
+	// limited is false if the number of simultaneous lists is not limited
+	limited bool
+	free    int
+}
+
+// newListSlots returns the slots of the sync
+func (c *clusterInfo) newListSlots() *listSlots {
+	limit := c.maxConcurrentListsPerSync
+	if limit == 0 {
+		limit = defaultMaxConcurrentListsPerSync
+	}
+	return &listSlots{cond: sync.NewCond(&sync.Mutex{}), limited: limit > 0, free: limit}
+}
+
+// acquire waits until the sync is allowed to perform one more list and returns the function which releases the slot
+func (s *listSlots) acquire() func() {
+	s.cond.L.Lock()
+	defer s.cond.L.Unlock()
+	for s.limited && s.free == 0 {
+		s.cond.Wait()
+	}
+	if s.limited {
+		s.free--
+	}
+	return func() {
+		s.cond.L.Lock()
+		defer s.cond.L.Unlock()
+		if s.limited {
+			s.free++
+		}
+		s.cond.Broadcast()
+	}
+}
+
+// aggregateSyncErrors combines errors of every failed kind into a single error
+func aggregateSyncErrors(syncErrors map[schema.GroupKind]error) error {
+	messages := make([]string, 0, len(syncErrors))
+	for gk, err := range syncErrors {
+		messages = append(messages, fmt.Sprintf("%s: %v", gk, err))
+	}
+	sort.Strings(messages)
+	return fmt.Errorf("failed to sync any API: %s", strings.Join(messages, "; "))
+}
+
+// getSyncErrors returns errors of kinds which failed to sync during the latest sync
+func (c *clusterInfo) getSyncErrors() map[schema.GroupKind]error {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	res := make(map[schema.GroupKind]error, len(c.syncErrors))
+	for gk, err := range c.syncErrors {
+		res[gk] = err
+	}
+	return res
+}
+
+func (c *clusterInfo) ensureSynced() error {
+	return c.ensureSyncedContext(context.Background())
+}
+
+// ensureSyncedContext syncs the cluster unless it has been synced recently. Sync is aborted and the context error is
+// returned as soon as the given context is done.
+func (c *clusterInfo) ensureSyncedContext(ctx context.Context) error {
+	synced, syncStateChanged, err := c.syncIfNeeded(ctx)
+	// handlers are invoked outside of the lock so it is safe to access the cache from them
+	if synced && c.onSyncCompleted != nil {
+		c.onSyncCompleted(err)
+	}
+	if syncStateChanged && c.onSyncStateChanged != nil {
+		c.onSyncStateChanged(err == nil, err)
+	}
+	return err
+}
+
+// syncIfNeeded syncs the cluster unless it has been synced recently. Returns true if the sync has been performed and true if
+// sync outcome has changed from success to failure or vice versa.
+func (c *clusterInfo) syncIfNeeded(ctx context.Context) (synced bool, syncStateChanged bool, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for {
+		if c.syncDone != nil {
+			if err := c.waitForSync(ctx); err != nil {
+				return false, false, err
+			}
+			continue
+		}
+		if c.stopped {
+			return false, false, errClusterCacheStopped
+		}
+		if c.synced() {
+			if c.syncError == nil && len(c.invalidatedNamespaces) > 0 {
+				if err := c.relistInvalidatedNamespaces(); err != nil {
+					return false, false, err
+				}
+			}
+			return false, false, c.syncError
+		}
+		if c.maxSyncRetries > 0 && c.syncFailures >= c.maxSyncRetries {
+			return false, false, fmt.Errorf("%w after %d consecutive failures: %v", ErrSyncRetriesExhausted, c.syncFailures, c.syncError)
+		}
+
+		// full sync lists resources of all namespaces
+		c.invalidatedNamespaces = nil
+		interrupted, err := c.runSync(ctx)
+		if ctx.Err() != nil {
+			// cancelled sync is not a sync failure, so next call should sync again
+			return false, false, ctx.Err()
+		}
+		if interrupted {
+			// the cache has been invalidated or stopped while the sync has released the lock
+			continue
+		}
+		syncTime := time.Now()
+		c.syncTime = &syncTime
+		c.syncError = err
+		syncStateChanged = c.lastSyncFailed != (err != nil)
+		c.lastSyncFailed = err != nil
+		if err != nil {
+			c.syncFailures++
+		} else {
+			c.syncFailures = 0
+		}
+		return true, syncStateChanged, c.syncError
+	}
+}
+
+// runSync performs the sync which can be waited for using syncDone and interrupted using interruptSync. Returns true if
+// the sync has been interrupted. The caller must hold the cluster lock.
+func (c *clusterInfo) runSync(ctx context.Context) (bool, error) {
+	syncCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	c.syncDone = make(chan struct{})
+	c.cancelSync = cancel
+	c.syncedKinds = make(map[schema.GroupKind]bool)
+	err := c.sync(syncCtx)
+	close(c.syncDone)
+	c.syncDone = nil
+	c.cancelSync = nil
+	c.syncedKinds = nil
+	return ctx.Err() == nil && syncCtx.Err() != nil, err
+}
+
+// waitForSync releases the cluster lock until the sync in progress completes or the given context is done. The caller
+// must hold the cluster lock.
+func (c *clusterInfo) waitForSync(ctx context.Context) error {
+	done := c.syncDone
+	c.lock.Unlock()
+	defer c.lock.Lock()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ensureKindsSynced is the same as ensureSyncedContext, but returns as soon as resources of the given kinds are available:
+// readers of the priority kinds don't wait for the sync in progress to list the rest of the kinds
+func (c *clusterInfo) ensureKindsSynced(ctx context.Context, gks []schema.GroupKind) error {
+	if c.kindsSynced(gks) {
+		return nil
+	}
+	return c.ensureSyncedContext(ctx)
+}
+
+// kindsSynced returns true if the sync in progress has already published resources of every given kind
+func (c *clusterInfo) kindsSynced(gks []schema.GroupKind) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.syncDone == nil || len(gks) == 0 {
+		return false
+	}
+	for _, gk := range gks {
+		if !c.syncedKinds[c.normalizeGroupKind(gk)] {
+			return false
+		}
+	}
+	return true
+}
+
+// getSyncStatus returns the state of the cluster cache sync. It does not wait for the sync in progress.
+func (c *clusterInfo) getSyncStatus() SyncStatus {
+	if atomic.LoadInt32(&c.syncing) == 1 {
+		return Syncing
+	}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	switch {
+	case c.syncTime == nil:
+		return NotSynced
+	case c.syncError != nil:
+		return SyncFailed
+	default:
+		return Synced
+	}
+}
+
+// getNamespaces returns sorted names of namespaces which have cached resources. Cluster level resources are indexed
+// under the empty namespace, which is not included.
+func (c *clusterInfo) getNamespaces() []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	res := make([]string, 0, len(c.nsIndex))
+	for ns := range c.nsIndex {
+		if ns != "" {
+			res = append(res, ns)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+func (c *clusterInfo) getNamespaceTopLevelResources(namespace string) map[kube.ResourceKey]appv1.ResourceNode {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	nodes := make(map[kube.ResourceKey]appv1.ResourceNode)
+	for _, node := range c.nsIndex[namespace] {
+		if len(node.ownerRefs) == 0 {
+			nodes[c.nodeKey(node)] = node.asResourceNode()
+		}
+	}
+	return nodes
+}
+
+// getDanglingResources returns resources of the specified namespace which have owner references but none of the owners
+// is cached. Such resources are neither top level resources nor visited while iterating hierarchy of any resource.
+func (c *clusterInfo) getDanglingResources(namespace string) []appv1.ResourceNode {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	ownerUIDs := make(map[types.UID]bool)
+	for _, ns := range []string{namespace, ""} {
+		for _, n := range c.nsIndex[ns] {
+			if n.ref.UID != "" {
+				ownerUIDs[n.ref.UID] = true
+			}
+		}
+	}
+	nodes := make([]appv1.ResourceNode, 0)
+	for _, n := range c.nsIndex[namespace] {
+		if len(n.ownerRefs) == 0 {
+			continue
+		}
+		hasOwner := false
+		for _, ownerRef := range n.ownerRefs {
+			if ownerRef.UID != "" {
+				hasOwner = ownerUIDs[ownerRef.UID]
+			} else {
+				group := ownerRefGV(ownerRef).Group
+				_, hasOwner = c.nodes[kube.NewResourceKey(group, ownerRef.Kind, namespace, ownerRef.Name)]
+				if !hasOwner {
+					_, hasOwner = c.nodes[kube.NewResourceKey(group, ownerRef.Kind, "", ownerRef.Name)]
+				}
+			}
+			if hasOwner {
+				break
+			}
+		}
+		if !hasOwner {
+			nodes = append(nodes, n.asResourceNode())
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		key1 := kube.NewResourceKey(nodes[i].Group, nodes[i].Kind, nodes[i].Namespace, nodes[i].Name)
+		key2 := kube.NewResourceKey(nodes[j].Group, nodes[j].Kind, nodes[j].Namespace, nodes[j].Name)
+		return strings.Compare(key1.String(), key2.String()) < 0
 	})
+	return nodes
+}
 
-	if err == nil {
-		err = c.startMissingWatches()
+// queryResources returns resources whose cached manifest matches the given predicate. Resources without cached manifest are skipped.
+func (c *clusterInfo) queryResources(predicate func(un *unstructured.Unstructured) bool) []appv1.ResourceNode {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	nodes := make([]appv1.ResourceNode, 0)
+	for _, node := range c.nodes {
+		if node.resource != nil && predicate(node.resource) {
+			nodes = append(nodes, node.asResourceNode())
+		}
 	}
+	sort.Slice(nodes, func(i, j int) bool {
+		key1 := kube.NewResourceKey(nodes[i].Group, nodes[i].Kind, nodes[i].Namespace, nodes[i].Name)
+		key2 := kube.NewResourceKey(nodes[j].Group, nodes[j].Kind, nodes[j].Namespace, nodes[j].Name)
+		return strings.Compare(key1.String(), key2.String()) < 0
+	})
+	return nodes
+}
 
-	if err != nil {
-		log.Errorf("Failed to sync cluster %s: %v", c.cluster.Server, err)
-		return err
+// getResourcesByLabel returns resources of the specified namespace, or of the whole cluster if the namespace is empty,
+// which labels match the given selector, sorted by resource key
+func (c *clusterInfo) getResourcesByLabel(selector labels.Selector, namespace string) []appv1.ResourceNode {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	candidates := c.nodes
+	if namespace != "" {
+		candidates = c.nsIndex[namespace]
 	}
-
-	c.log.Info("Cluster successfully synced")
-	return nil
+	keys := make([]kube.ResourceKey, 0)
+	for key, n := range candidates {
+		if selector.Matches(labels.Set(n.labels)) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	nodes := make([]appv1.ResourceNode, len(keys))
+	for i := range keys {
+		nodes[i] = candidates[keys[i]].asResourceNode()
+	}
+	return nodes
 }
 
-func (c *clusterInfo) ensureSynced() error {
-	c.lock.Lock()
-	defer c.lock.Unlock()
-	if c.synced() {
-		return c.syncError
+// getSortedResources returns resources of the specified namespace sorted using the given comparator
+func (c *clusterInfo) getSortedResources(namespace string, less func(a, b *appv1.ResourceNode) bool) []appv1.ResourceNode {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	nsNodes := c.nsIndex[namespace]
+	nodes := make([]appv1.ResourceNode, 0, len(nsNodes))
+	for _, node := range nsNodes {
+		nodes = append(nodes, node.asResourceNode())
 	}
-
-	err := c.sync()
-	syncTime := time.Now()
-	c.syncTime = &syncTime
-	c.syncError = err
-	return c.syncError
+	sort.Slice(nodes, func(i, j int) bool {
+		return less(&nodes[i], &nodes[j])
+	})
+	return nodes
 }
 
-func (c *clusterInfo) getNamespaceTopLevelResources(namespace string) map[kube.ResourceKey]appv1.ResourceNode {
-	c.lock.Lock()
-	defer c.lock.Unlock()
-	nodes := make(map[kube.ResourceKey]appv1.ResourceNode)
-	for _, node := range c.nsIndex[namespace] {
-		if len(node.ownerRefs) == 0 {
-			nodes[node.resourceKey()] = node.asResourceNode()
+// getControllerChildren returns direct children of the specified resource which are controlled by it
+func (c *clusterInfo) getControllerChildren(key kube.ResourceKey) []appv1.ResourceNode {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	children := make([]appv1.ResourceNode, 0)
+	key = c.normalizeKey(key)
+	parent, ok := c.nodes[key]
+	if !ok {
+		return children
+	}
+	for _, child := range c.nsIndex[key.Namespace] {
+		if parent.isControllerOf(child) {
+			children = append(children, child.asResourceNode())
 		}
 	}
-	return nodes
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].Name < children[j].Name
+	})
+	return children
+}
+
+// getResource returns the cached resource with the given key
+func (c *clusterInfo) getResource(key kube.ResourceKey) (*appv1.ResourceNode, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	n, ok := c.nodes[c.normalizeKey(key)]
+	if !ok {
+		return nil, false
+	}
+	res := n.asResourceNode()
+	return &res, true
 }
 
+// iterateHierarchy executes the given action against the resource specified by the key and all its children. The
+// hierarchy is captured under the lock and the action is executed outside of the lock, so slow action doesn't block
+// events processing. As a result the iterated hierarchy might be slightly stale.
 func (c *clusterInfo) iterateHierarchy(key kube.ResourceKey, action func(child appv1.ResourceNode, appName string)) {
-	c.lock.Lock()
-	defer c.lock.Unlock()
+	type hierarchyNode struct {
+		resource appv1.ResourceNode
+		appName  string
+	}
+	var hierarchy []hierarchyNode
+	c.collectHierarchy(key, func(child appv1.ResourceNode, appName string) {
+		hierarchy = append(hierarchy, hierarchyNode{resource: child, appName: appName})
+	})
+	for _, n := range hierarchy {
+		action(n.resource, n.appName)
+	}
+}
+
+// snapshotHierarchy returns a deep copy of the resource specified by the key and all its children captured at a single
+// point in time, so the hierarchy can be processed later without the risk of concurrent modifications
+func (c *clusterInfo) snapshotHierarchy(key kube.ResourceKey) []appv1.ResourceNode {
+	res := make([]appv1.ResourceNode, 0)
+	c.collectHierarchy(key, func(child appv1.ResourceNode, appName string) {
+		res = append(res, *child.DeepCopy())
+	})
+	return res
+}
+
+func (c *clusterInfo) collectHierarchy(key kube.ResourceKey, action func(child appv1.ResourceNode, appName string)) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	key = c.normalizeKey(key)
 	if objInfo, ok := c.nodes[key]; ok {
 		nsNodes := c.nsIndex[key.Namespace]
 		action(objInfo.asResourceNode(), objInfo.getApp(nsNodes))
@@ -437,23 +2766,115 @@ func (c *clusterInfo) iterateHierarchy(key kube.ResourceKey, action func(child a
 				})
 				child := children[0]
 				action(child.asResourceNode(), child.getApp(nsNodes))
-				child.iterateChildren(nsNodes, map[kube.ResourceKey]bool{objInfo.resourceKey(): true}, action)
+				child.iterateChildren(nsNodes, newResourceKeySet(nil, c.nodeKey(objInfo), c.nodeKey(child)), action)
+			}
+		}
+	}
+}
+
+// iterateHierarchyV2 executes the callback against the resources specified by the keys and all their children. Unlike
+// iterateHierarchy, children are not de-duplicated by UID, so the resource owned by several parents is visited, but
+// every resource is visited at most once across all roots. Children of the resource are skipped if the callback returns
+// false. Same as iterateHierarchy, the callback is invoked outside of the cluster lock.
+func (c *clusterInfo) iterateHierarchyV2(keys []kube.ResourceKey, action func(child appv1.ResourceNode, appName string) bool) {
+	type hierarchyNode struct {
+		resource appv1.ResourceNode
+		appName  string
+		children []kube.ResourceKey
+	}
+	hierarchy := make(map[kube.ResourceKey]*hierarchyNode)
+	var collect func(n *node, nsNodes map[kube.ResourceKey]*node)
+	collect = func(n *node, nsNodes map[kube.ResourceKey]*node) {
+		key := c.nodeKey(n)
+		if _, ok := hierarchy[key]; ok {
+			return
+		}
+		hn := &hierarchyNode{resource: n.asResourceNode(), appName: n.getApp(nsNodes)}
+		hierarchy[key] = hn
+		for childKey, child := range nsNodes {
+			if n.isParentOf(child) {
+				hn.children = append(hn.children, childKey)
 			}
 		}
+		// visit children in the same order after every refresh
+		sort.Slice(hn.children, func(i, j int) bool {
+			return strings.Compare(hn.children[i].String(), hn.children[j].String()) < 0
+		})
+		for _, childKey := range hn.children {
+			collect(nsNodes[childKey], nsNodes)
+		}
+	}
+	c.lock.RLock()
+	roots := make([]kube.ResourceKey, len(keys))
+	for i := range keys {
+		roots[i] = c.normalizeKey(keys[i])
+		if n, ok := c.nodes[roots[i]]; ok {
+			collect(n, c.nsIndex[roots[i].Namespace])
+		}
+	}
+	c.lock.RUnlock()
+
+	visited := make(map[kube.ResourceKey]bool)
+	var visit func(key kube.ResourceKey)
+	visit = func(key kube.ResourceKey) {
+		hn, ok := hierarchy[key]
+		if !ok || visited[key] {
+			return
+		}
+		visited[key] = true
+		if !action(hn.resource, hn.appName) {
+			return
+		}
+		for _, childKey := range hn.children {
+			visit(childKey)
+		}
+	}
+	for _, key := range roots {
+		visit(key)
+	}
+}
+
+// isNamespacedKnown returns true if the given kind is namespaced and false if the kind is unknown to the cache, e.g. it
+// is not watched, in which case it is not known whether the kind is namespaced
+func (c *clusterInfo) isNamespacedKnown(gk schema.GroupKind) (namespaced bool, known bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	api, ok := c.getAPIMeta(gk)
+	if !ok {
+		return false, false
 	}
+	return api.namespaced, true
 }
 
 func (c *clusterInfo) isNamespaced(gk schema.GroupKind) bool {
-	if api, ok := c.apisMeta[gk]; ok && !api.namespaced {
+	if api, ok := c.getAPIMeta(gk); ok && !api.namespaced {
 		return false
 	}
 	return true
 }
 
 func (c *clusterInfo) getManagedLiveObjs(a *appv1.Application, targetObjs []*unstructured.Unstructured, metricsServer *metrics.MetricsServer) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
-	c.lock.Lock()
-	defer c.lock.Unlock()
+	return c.getManagedLiveObjsContext(context.Background(), a, targetObjs, metricsServer)
+}
+
+// getManagedLiveObjsContext is the same as getManagedLiveObjs, but stops waiting for outstanding live queries and
+// returns the context error as soon as the given context is done
+func (c *clusterInfo) getManagedLiveObjsContext(ctx context.Context, a *appv1.Application, targetObjs []*unstructured.Unstructured, metricsServer *metrics.MetricsServer) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	// liveTarget is the target object which live state is either found in the cache or should be loaded from the cluster
+	type liveTarget struct {
+		key        kube.ResourceKey
+		managedObj *unstructured.Unstructured
+		// node is set if managedObj is the cached resource of the node, so the conversion result can be reused
+		node *node
+		// queryLive is true if the resource should be loaded from the cluster using the name and the namespace
+		queryLive     bool
+		liveName      string
+		liveNamespace string
+	}
 
+	// the cache is not modified: resources missing in cache are retrieved directly from the cluster and are not cached.
+	// The read lock is released before querying the cluster, so pending cache updates don't wait for the queries.
+	c.lock.RLock()
 	managedObjs := make(map[kube.ResourceKey]*unstructured.Unstructured)
 	// iterate all objects in live state cache to find ones associated with app
 	for key, o := range c.nodes {
@@ -461,49 +2882,79 @@ func (c *clusterInfo) getManagedLiveObjs(a *appv1.Application, targetObjs []*uns
 			managedObjs[key] = o.resource
 		}
 	}
-	config := metrics.AddMetricsTransportWrapper(metricsServer, a, c.cluster.RESTConfig())
 	// iterate target objects and identify ones that already exist in the cluster,\
 	// but are simply missing our label
-	lock := &sync.Mutex{}
-	err := util.RunAllAsync(len(targetObjs), func(i int) error {
-		targetObj := targetObjs[i]
+	orderedObjs := sortByGroupKind(targetObjs)
+	targets := make([]liveTarget, len(orderedObjs))
+	for i, targetObj := range orderedObjs {
 		key := GetTargetObjKey(a, targetObj, c.isNamespaced(targetObj.GroupVersionKind().GroupKind()))
-		lock.Lock()
-		managedObj := managedObjs[key]
-		lock.Unlock()
-
-		if managedObj == nil {
-			if existingObj, exists := c.nodes[key]; exists {
+		// resources are cached under the canonical key, but the live state is returned under the key of the target, so
+		// the resource served by several API groups is not reported both as missing and as extra resource
+		cacheKey := c.normalizeKey(key)
+		target := liveTarget{key: key, managedObj: managedObjs[cacheKey]}
+		if cacheKey != key {
+			delete(managedObjs, cacheKey)
+		}
+		if target.managedObj == nil {
+			if existingObj, exists := c.nodes[cacheKey]; exists {
 				if existingObj.resource != nil {
-					managedObj = existingObj.resource
+					target.managedObj = existingObj.resource
+				} else if c.preferCachedNodes {
+					target.managedObj = existingObj.asUnstructured()
 				} else {
-					var err error
-					managedObj, err = c.kubectl.GetResource(config, targetObj.GroupVersionKind(), existingObj.ref.Name, existingObj.ref.Namespace)
-					if err != nil {
-						if errors.IsNotFound(err) {
-							return nil
-						}
-						return err
-					}
+					target.queryLive, target.liveName, target.liveNamespace = true, existingObj.ref.Name, existingObj.ref.Namespace
 				}
-			} else if _, watched := c.apisMeta[key.GroupKind()]; !watched {
-				var err error
-				managedObj, err = c.kubectl.GetResource(config, targetObj.GroupVersionKind(), targetObj.GetName(), targetObj.GetNamespace())
-				if err != nil {
-					if errors.IsNotFound(err) {
-						return nil
-					}
-					return err
+			} else if _, watched := c.getAPIMeta(key.GroupKind()); !watched {
+				target.queryLive, target.liveName, target.liveNamespace = true, targetObj.GetName(), targetObj.GetNamespace()
+			}
+		}
+		if n, ok := c.nodes[cacheKey]; ok && n.resource != nil && n.resource == target.managedObj {
+			target.node = n
+		}
+		targets[i] = target
+	}
+	config := metrics.AddMetricsTransportWrapper(metricsServer, a, c.cluster.RESTConfig())
+	if c.listConfigTweak != nil {
+		c.listConfigTweak(config)
+	}
+	c.lock.RUnlock()
+
+	lock := &sync.Mutex{}
+	err := util.RunAllAsync(len(targets), func(i int) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		targetObj, target := orderedObjs[i], targets[i]
+		managedObj := target.managedObj
+		if target.queryLive {
+			var err error
+			managedObj, err = c.getLiveResource(ctx, config, targetObj.GroupVersionKind(), target.liveName, target.liveNamespace)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil
 				}
+				return err
 			}
 		}
 
 		if managedObj != nil {
-			converted, err := c.kubectl.ConvertToVersion(managedObj, targetObj.GroupVersionKind().Group, targetObj.GroupVersionKind().Version)
+			var converted *unstructured.Unstructured
+			var err error
+			if target.node != nil {
+				// reuse the result of the previous conversion of the cached resource
+				converted, err = target.node.convertResource(c.kubectl, targetObj.GroupVersionKind().GroupVersion())
+			} else {
+				release, slotErr := c.acquireLiveQuerySlot(ctx)
+				if slotErr != nil {
+					return slotErr
+				}
+				converted, err = c.kubectl.ConvertToVersion(managedObj, targetObj.GroupVersionKind().Group, targetObj.GroupVersionKind().Version)
+				release()
+			}
 			if err != nil {
 				// fallback to loading resource from kubernetes if conversion fails
 				log.Warnf("Failed to convert resource: %v", err)
-				managedObj, err = c.kubectl.GetResource(config, targetObj.GroupVersionKind(), managedObj.GetName(), managedObj.GetNamespace())
+				managedObj, err = c.getLiveResource(ctx, config, targetObj.GroupVersionKind(), managedObj.GetName(), managedObj.GetNamespace())
 				if err != nil {
 					if errors.IsNotFound(err) {
 						return nil
@@ -514,11 +2965,14 @@ func (c *clusterInfo) getManagedLiveObjs(a *appv1.Application, targetObjs []*uns
 				managedObj = converted
 			}
 			lock.Lock()
-			managedObjs[key] = managedObj
+			managedObjs[target.key] = managedObj
 			lock.Unlock()
 		}
 		return nil
 	})
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -526,13 +2980,133 @@ func (c *clusterInfo) getManagedLiveObjs(a *appv1.Application, targetObjs []*uns
 	return managedObjs, nil
 }
 
+// sortByGroupKind returns a copy of the given objects sorted by group and kind, so resources of the same kind are
+// loaded from the cluster one after another and the load is not spread over random kinds. Objects of the same kind keep
+// their relative order.
+func sortByGroupKind(objs []*unstructured.Unstructured) []*unstructured.Unstructured {
+	res := make([]*unstructured.Unstructured, len(objs))
+	copy(res, objs)
+	sort.SliceStable(res, func(i, j int) bool {
+		gk1, gk2 := res[i].GroupVersionKind().GroupKind(), res[j].GroupVersionKind().GroupKind()
+		if gk1.Group != gk2.Group {
+			return gk1.Group < gk2.Group
+		}
+		return gk1.Kind < gk2.Kind
+	})
+	return res
+}
+
+// setMaxConcurrentLiveQueries changes the limit of concurrent live queries. Queries in progress release the slots of
+// the previous limit. The caller must hold the cluster lock.
+func (c *clusterInfo) setMaxConcurrentLiveQueries(limit int) {
+	if limit == c.maxConcurrentLiveQueries {
+		return
+	}
+	c.maxConcurrentLiveQueries = limit
+	c.liveQuerySlots = nil
+	if limit > 0 {
+		c.liveQuerySlots = make(chan struct{}, limit)
+	}
+}
+
+// acquireLiveQuerySlot waits until the live query is allowed to run and returns the function which releases the slot
+func (c *clusterInfo) acquireLiveQuerySlot(ctx context.Context) (func(), error) {
+	c.lock.RLock()
+	slots := c.liveQuerySlots
+	c.lock.RUnlock()
+	if slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return func() {
+		<-slots
+	}, nil
+}
+
+// getLiveResource loads the resource from the cluster, limiting the number of concurrent queries. The client does not
+// support cancellation, so the function returns the context error as soon as the context is done without waiting for
+// the query to complete.
+func (c *clusterInfo) getLiveResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+	release, err := c.acquireLiveQuerySlot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	type getResult struct {
+		un  *unstructured.Unstructured
+		err error
+	}
+	resultCh := make(chan getResult, 1)
+	go func() {
+		defer release()
+		un, err := c.kubectl.GetResource(config, gvk, name, namespace)
+		resultCh <- getResult{un: un, err: err}
+	}()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-resultCh:
+		return res.un, res.err
+	}
+}
+
+// normalizeLiveObj returns a copy of the live object without fields populated by the server, normalized using the given
+// normalizer. Such object is smaller and is suitable for diffing only: e.g. health assessment requires the object status.
+func normalizeLiveObj(un *unstructured.Unstructured, normalizer diff.Normalizer) *unstructured.Unstructured {
+	un = un.DeepCopy()
+	unstructured.RemoveNestedField(un.Object, "status")
+	for _, field := range []string{"managedFields", "resourceVersion", "uid", "selfLink", "generation", "creationTimestamp"} {
+		unstructured.RemoveNestedField(un.Object, "metadata", field)
+	}
+	diff.Normalize(un, normalizer)
+	return un
+}
+
+// computeLiveTargetPatches returns patches which reconcile the given live objects with the corresponding target objects.
+// Target objects which don't exist in the cluster and live objects which are in sync with target are skipped.
+func (c *clusterInfo) computeLiveTargetPatches(a *appv1.Application, targetObjs []*unstructured.Unstructured, liveObjs map[kube.ResourceKey]*unstructured.Unstructured) (map[kube.ResourceKey][]byte, error) {
+	c.lock.RLock()
+	keys := make([]kube.ResourceKey, len(targetObjs))
+	for i, targetObj := range targetObjs {
+		keys[i] = GetTargetObjKey(a, targetObj, c.isNamespaced(targetObj.GroupVersionKind().GroupKind()))
+	}
+	c.lock.RUnlock()
+
+	patches := make(map[kube.ResourceKey][]byte)
+	for i, targetObj := range targetObjs {
+		liveObj, ok := liveObjs[keys[i]]
+		if !ok || liveObj == nil {
+			continue
+		}
+		patch, err := diff.LiveTargetPatch(targetObj, liveObj)
+		if err != nil {
+			return nil, err
+		}
+		if string(patch) != "{}" {
+			patches[keys[i]] = patch
+		}
+	}
+	return patches, nil
+}
+
 func (c *clusterInfo) processEvent(event watch.EventType, un *unstructured.Unstructured) {
+	receivedAt := time.Now()
 	if c.onEventReceived != nil {
 		c.onEventReceived(event, un)
 	}
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	key := kube.GetResourceKey(un)
+	defer func() {
+		c.eventProcessingLag = time.Since(receivedAt)
+	}()
+	if c.stopped {
+		return
+	}
+	key := c.getResourceKey(un)
+	c.recordEvent(kube.GetResourceKey(un).GroupKind(), event, receivedAt)
 	existingNode, exists := c.nodes[key]
 	if event == watch.Deleted {
 		if exists {
@@ -543,6 +3117,41 @@ func (c *clusterInfo) processEvent(event watch.EventType, un *unstructured.Unstr
 	}
 }
 
+// recordEvent increments the number of received events of the given kind
+func (c *clusterInfo) recordEvent(gk schema.GroupKind, event watch.EventType, receivedAt time.Time) {
+	if c.eventStats == nil {
+		c.eventStats = make(map[schema.GroupKind]*EventStats)
+	}
+	stats, ok := c.eventStats[gk]
+	if !ok {
+		stats = &EventStats{}
+		c.eventStats[gk] = stats
+	}
+	switch event {
+	case watch.Added:
+		stats.Added++
+	case watch.Modified:
+		stats.Modified++
+	case watch.Deleted:
+		stats.Deleted++
+	}
+	stats.LastEventTime = receivedAt
+}
+
+// getEventStats returns the number of watch events received for each kind. Stats are reset if requested.
+func (c *clusterInfo) getEventStats(reset bool) map[schema.GroupKind]EventStats {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := make(map[schema.GroupKind]EventStats, len(c.eventStats))
+	for gk, stats := range c.eventStats {
+		res[gk] = *stats
+	}
+	if reset {
+		c.eventStats = nil
+	}
+	return res
+}
+
 func (c *clusterInfo) onNodeUpdated(exists bool, existingNode *node, un *unstructured.Unstructured, key kube.ResourceKey) {
 	nodes := make([]*node, 0)
 	if exists {
@@ -562,7 +3171,64 @@ func (c *clusterInfo) onNodeUpdated(exists bool, existingNode *node, un *unstruc
 			toNotify[app] = n.isRootAppNode() || toNotify[app]
 		}
 	}
-	c.onObjectUpdated(toNotify, newObj.ref)
+	event := watch.Added
+	if exists {
+		event = watch.Modified
+	}
+	c.publishEvent(event, newObj, existingNode)
+	if !exists || !c.skipNoOpUpdates || !isNoOpUpdate(existingNode, newObj) {
+		c.notifyObjectUpdated(key, toNotify, newObj.ref, event)
+	}
+	if exists && c.onResourceInfoChanged != nil {
+		oldRes, newRes := existingNode.asResourceNode(), newObj.asResourceNode()
+		equal := isResourceInfoEqual
+		if c.resourceInfoEqual != nil {
+			equal = c.resourceInfoEqual
+		}
+		if !equal(&oldRes, &newRes) {
+			c.invokeHandler("ResourceInfoChanged", key, func() {
+				c.onResourceInfoChanged(oldRes, newRes)
+			})
+		}
+	}
+	if !exists && c.onParentResolved != nil {
+		c.notifyParentResolved(newObj)
+	}
+}
+
+// notifyParentResolved notifies the handler about cached children of the newly added parent. Namespaced parent might
+// own resources of its namespace only, so the whole cache is scanned for cluster level parents only.
+func (c *clusterInfo) notifyParentResolved(parent *node) {
+	candidates := c.nodes
+	if parent.ref.Namespace != "" {
+		candidates = c.nsIndex[parent.ref.Namespace]
+	}
+	for key, child := range candidates {
+		if !parent.isParentOf(child) {
+			continue
+		}
+		childRef := child.ref
+		c.invokeHandler("ParentResolved", key, func() {
+			c.onParentResolved(parent.ref, childRef)
+		})
+	}
+}
+
+// isNoOpUpdate returns true if the updated node differs from the existing one by the resource version only
+func isNoOpUpdate(existingNode *node, newNode *node) bool {
+	if existingNode.contentHash == 0 || existingNode.contentHash != newNode.contentHash || existingNode.appName != newNode.appName {
+		return false
+	}
+	oldRes, newRes := existingNode.asResourceNode(), newNode.asResourceNode()
+	return isResourceInfoEqual(&oldRes, &newRes)
+}
+
+// isResourceInfoEqual returns true if the information computed for both resources is the same
+func isResourceInfoEqual(oldRes, newRes *appv1.ResourceNode) bool {
+	return reflect.DeepEqual(oldRes.Info, newRes.Info) &&
+		reflect.DeepEqual(oldRes.NetworkingInfo, newRes.NetworkingInfo) &&
+		reflect.DeepEqual(oldRes.Images, newRes.Images) &&
+		reflect.DeepEqual(oldRes.Health, newRes.Health)
 }
 
 func (c *clusterInfo) onNodeRemoved(key kube.ResourceKey, n *node) {
@@ -576,7 +3242,120 @@ func (c *clusterInfo) onNodeRemoved(key kube.ResourceKey, n *node) {
 	if appName != "" {
 		managedByApp[appName] = n.isRootAppNode()
 	}
-	c.onObjectUpdated(managedByApp, n.ref)
+	c.publishEvent(watch.Deleted, nil, n)
+	if c.onObjectRemoved != nil {
+		c.invokeHandler("ObjectRemoved", key, func() {
+			c.onObjectRemoved(managedByApp, n.ref)
+		})
+	} else {
+		c.notifyObjectUpdated(key, managedByApp, n.ref, watch.Deleted)
+	}
+}
+
+type pendingNotification struct {
+	managedByApp map[string]bool
+	ref          v1.ObjectReference
+	event        watch.EventType
+}
+
+// notifyObjectUpdated invokes onObjectUpdated handler or, if the event coalesce window is configured, postpones the
+// notification until the end of the window merging it with the pending notification about the same resource
+func (c *clusterInfo) notifyObjectUpdated(key kube.ResourceKey, managedByApp map[string]bool, ref v1.ObjectReference, event watch.EventType) {
+	if c.eventCoalesceWindow <= 0 {
+		c.invokeHandler("ObjectUpdated", key, func() {
+			c.onObjectUpdated(managedByApp, ref, event)
+		})
+		return
+	}
+	if c.pendingNotifications == nil {
+		c.pendingNotifications = make(map[kube.ResourceKey]*pendingNotification)
+	}
+	pending, ok := c.pendingNotifications[key]
+	if !ok {
+		c.pendingNotifications[key] = &pendingNotification{managedByApp: managedByApp, ref: ref, event: event}
+	} else {
+		for app, isRoot := range managedByApp {
+			pending.managedByApp[app] = isRoot || pending.managedByApp[app]
+		}
+		pending.ref = ref
+		if coalesced, ok := coalesceEvents(pending.event, event); ok {
+			pending.event = coalesced
+		} else {
+			delete(c.pendingNotifications, key)
+		}
+	}
+	if c.coalesceDone == nil {
+		newTicker := c.coalesceTicker
+		if newTicker == nil {
+			newTicker = newCoalesceTicker
+		}
+		ticks, stopTicker := newTicker(c.eventCoalesceWindow)
+		c.coalesceDone = make(chan struct{})
+		go c.flushNotificationsLoop(ticks, stopTicker, c.coalesceDone)
+	}
+}
+
+// coalesceEvents returns the event which is reported for the resource that has received both the pending and the new
+// event within the coalesce window. Returns false if nothing should be reported.
+func coalesceEvents(pending watch.EventType, event watch.EventType) (watch.EventType, bool) {
+	switch {
+	case event == watch.Deleted:
+		// the resource added within the window has never been reported, so there is nothing to report once it is deleted
+		return watch.Deleted, pending != watch.Added
+	case pending == watch.Added:
+		return watch.Added, true
+	case event == watch.Added:
+		// the resource existed before the window, so the re-created resource is reported as modified
+		return watch.Modified, true
+	default:
+		return event, true
+	}
+}
+
+func newCoalesceTicker(window time.Duration) (<-chan time.Time, func()) {
+	ticker := time.NewTicker(window)
+	return ticker.C, ticker.Stop
+}
+
+// flushNotificationsLoop flushes pending notifications at the end of every event coalesce window until the loop is
+// stopped by the cache invalidation or stop
+func (c *clusterInfo) flushNotificationsLoop(ticks <-chan time.Time, stopTicker func(), done <-chan struct{}) {
+	defer stopTicker()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticks:
+			c.flushNotifications()
+		}
+	}
+}
+
+// flushNotifications notifies onObjectUpdated about the pending changes
+func (c *clusterInfo) flushNotifications() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.flushPendingNotifications()
+}
+
+// flushPendingNotifications notifies onObjectUpdated about the pending changes. The caller must hold the cluster lock.
+func (c *clusterInfo) flushPendingNotifications() {
+	pending := c.pendingNotifications
+	c.pendingNotifications = nil
+	for key, n := range pending {
+		c.invokeHandler("ObjectUpdated", key, func() {
+			c.onObjectUpdated(n.managedByApp, n.ref, n.event)
+		})
+	}
+}
+
+// stopCoalescing stops the pending notifications flush loop. The loop is restarted, using the current event coalesce
+// window, by the next notification. The caller must hold the cluster lock.
+func (c *clusterInfo) stopCoalescing() {
+	if c.coalesceDone != nil {
+		close(c.coalesceDone)
+		c.coalesceDone = nil
+	}
 }
 
 var (
@@ -586,8 +3365,8 @@ var (
 )
 
 func (c *clusterInfo) getClusterInfo() metrics.ClusterInfo {
-	c.lock.Lock()
-	defer c.lock.Unlock()
+	c.lock.RLock()
+	defer c.lock.RUnlock()
 	return metrics.ClusterInfo{
 		APIsCount:         len(c.apisMeta),
 		K8SVersion:        c.serverVersion,
@@ -597,6 +3376,199 @@ func (c *clusterInfo) getClusterInfo() metrics.ClusterInfo {
 	}
 }
 
+// getInventoryMatrix returns the number of cached resources of each kind grouped by namespace. Cluster level resources
+// are counted under the empty namespace.
+func (c *clusterInfo) getInventoryMatrix() map[string]map[schema.GroupKind]int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	res := make(map[string]map[schema.GroupKind]int)
+	for key := range c.nodes {
+		counts, ok := res[key.Namespace]
+		if !ok {
+			counts = make(map[schema.GroupKind]int)
+			res[key.Namespace] = counts
+		}
+		counts[key.GroupKind()]++
+	}
+	return res
+}
+
+// getStaleResources returns sorted keys of the resources which have not been listed, updated by the watch or confirmed by
+// the watch bookmark within the given period. Stale resources might indicate stalled watch.
+func (c *clusterInfo) getStaleResources(olderThan time.Duration) []kube.ResourceKey {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	threshold := time.Now().Add(-olderThan)
+	res := make([]kube.ResourceKey, 0)
+	for key, n := range c.nodes {
+		lastSeen := n.lastUpdated
+		if info, ok := c.apisMeta[key.GroupKind()]; ok {
+			ns := key.Namespace
+			if len(c.cluster.Namespaces) == 0 {
+				// cluster level watch
+				ns = ""
+			}
+			if bookmarkTime, ok := info.bookmarkTimes[ns]; ok && bookmarkTime.After(lastSeen) {
+				lastSeen = bookmarkTime
+			}
+		}
+		if lastSeen.Before(threshold) {
+			res = append(res, key)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return strings.Compare(res[i].String(), res[j].String()) < 0
+	})
+	return res
+}
+
+// getOversizedResources returns sorted keys of the resources which manifests are not cached because of the size limit
+func (c *clusterInfo) getOversizedResources() []kube.ResourceKey {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	res := make([]kube.ResourceKey, 0)
+	for key, n := range c.nodes {
+		if n.oversized {
+			res = append(res, key)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return strings.Compare(res[i].String(), res[j].String()) < 0
+	})
+	return res
+}
+
+// getEmptyWatchedKinds returns sorted kinds which are watched but have no cached resources, so that the kinds which
+// have no resources in the cluster can be distinguished from the kinds which are not watched at all
+func (c *clusterInfo) getEmptyWatchedKinds() []schema.GroupKind {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	nonEmpty := make(map[schema.GroupKind]bool)
+	for key := range c.nodes {
+		nonEmpty[key.GroupKind()] = true
+	}
+	res := make([]schema.GroupKind, 0)
+	for gk := range c.apisMeta {
+		if !nonEmpty[gk] {
+			res = append(res, gk)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Group != res[j].Group {
+			return res[i].Group < res[j].Group
+		}
+		return res[i].Kind < res[j].Kind
+	})
+	return res
+}
+
+// getResourceCountByGroupKind returns the number of cached resources of each kind
+func (c *clusterInfo) getResourceCountByGroupKind() map[schema.GroupKind]int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	res := make(map[schema.GroupKind]int)
+	for key := range c.nodes {
+		res[key.GroupKind()]++
+	}
+	return res
+}
+
+// getEventProcessingLag returns the time it took to process the latest watch event including the time spent waiting for
+// the cache lock. Growing lag means the cache is stale because of processing backlog rather than watch failure.
+func (c *clusterInfo) getEventProcessingLag() time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.eventProcessingLag
+}
+
+// getCacheGeneration returns the number which is incremented on every change of the cached resources, so callers can
+// skip re-processing the cache if the generation has not changed
+func (c *clusterInfo) getCacheGeneration() uint64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.generation
+}
+
+// changesSince returns keys of resources added, updated or removed since the generation encoded in the given token and
+// the token which should be used to retrieve the next changes. Empty token returns keys of all cached resources. Tokens
+// issued before the last full sync are expired since full sync resets change tracking.
+func (c *clusterInfo) changesSince(token string) ([]kube.ResourceKey, string, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	nextToken := strconv.FormatUint(c.generation, 10)
+	keys := make([]kube.ResourceKey, 0)
+	if token == "" {
+		for key := range c.nodes {
+			keys = append(keys, key)
+		}
+	} else {
+		since, err := strconv.ParseUint(token, 10, 64)
+		if err != nil || since > c.generation {
+			return nil, "", fmt.Errorf("invalid changes token '%s'", token)
+		}
+		if since < c.changesResetGeneration {
+			return nil, "", fmt.Errorf("changes token '%s' has expired", token)
+		}
+		for key, generation := range c.changedKeys {
+			if generation > since {
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return strings.Compare(keys[i].String(), keys[j].String()) < 0
+	})
+	return keys, nextToken, nil
+}
+
+// dumpResources returns a copy of the cached state of every resource. Returned snapshots don't share any data with the
+// cache, so modifying them does not affect the cache.
+func (c *clusterInfo) dumpResources() map[kube.ResourceKey]ResourceSnapshot {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	res := make(map[kube.ResourceKey]ResourceSnapshot, len(c.nodes))
+	for key, n := range c.nodes {
+		snapshot := ResourceSnapshot{Ref: n.ref, ResourceVersion: n.resourceVersion, ManifestCached: n.resource != nil, LastUpdated: n.lastUpdated}
+		for i := range n.ownerRefs {
+			snapshot.OwnerRefs = append(snapshot.OwnerRefs, *n.ownerRefs[i].DeepCopy())
+		}
+		res[key] = snapshot
+	}
+	return res
+}
+
+// dumpWatchConfig returns the watch configuration of every kind known to the cluster cache, sorted by group and kind
+func (c *clusterInfo) dumpWatchConfig() WatchConfig {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	config := WatchConfig{Server: c.cluster.Server, Kinds: make([]KindWatchConfig, 0, len(c.apisMeta))}
+	for gk, info := range c.apisMeta {
+		kindConfig := KindWatchConfig{
+			GroupKind:        gk,
+			Namespaced:       info.namespaced,
+			ResourceVersion:  info.resourceVersion(""),
+			ResourceVersions: info.namespaceResourceVersions(),
+			Watching:         info.watching,
+		}
+		if c.resourceLabelSelector != nil && !c.resourceLabelSelector.Empty() {
+			kindConfig.LabelSelector = c.resourceLabelSelector.String()
+		}
+		kindConfig.FieldSelector = c.fieldSelector(gk)
+		if info.namespaced && len(c.cluster.Namespaces) > 0 {
+			kindConfig.Namespaces = append([]string{}, c.cluster.Namespaces...)
+		}
+		config.Kinds = append(config.Kinds, kindConfig)
+	}
+	sort.Slice(config.Kinds, func(i, j int) bool {
+		gk1, gk2 := config.Kinds[i].GroupKind, config.Kinds[j].GroupKind
+		if gk1.Group != gk2.Group {
+			return gk1.Group < gk2.Group
+		}
+		return gk1.Kind < gk2.Kind
+	})
+	return config
+}
+
 // skipAppRequeing checks if the object is an API type which we want to skip requeuing against.
 // We ignore API types which have a high churn rate, and/or whose updates are irrelevant to the app
 func skipAppRequeing(key kube.ResourceKey) bool {
@@ -1,26 +1,41 @@
 package cache
 
 import (
+	"context"
+	goerrors "errors"
 	"fmt"
+	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/rest"
+	testcore "k8s.io/client-go/testing"
+	"k8s.io/client-go/util/flowcontrol"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/errors"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/health"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/kube/kubetest"
 )
@@ -153,9 +168,9 @@ func newCluster(objs ...*unstructured.Unstructured) *clusterInfo {
 
 func newClusterExt(kubectl kube.Kubectl) *clusterInfo {
 	return &clusterInfo{
-		lock:            &sync.Mutex{},
+		lock:            &sync.RWMutex{},
 		nodes:           make(map[kube.ResourceKey]*node),
-		onObjectUpdated: func(managedByApp map[string]bool, reference corev1.ObjectReference) {},
+		onObjectUpdated: func(managedByApp map[string]bool, reference corev1.ObjectReference, event watch.EventType) {},
 		kubectl:         kubectl,
 		nsIndex:         make(map[string]map[kube.ResourceKey]*node),
 		cluster:         &appv1.Cluster{},
@@ -225,6 +240,245 @@ func TestEnsureSyncedSingleNamespace(t *testing.T) {
 	assert.ElementsMatch(t, []string{"helm-guestbook1"}, names)
 }
 
+func TestNamespaceResourceFilter(t *testing.T) {
+	obj1 := strToUnstructured(`
+  apiVersion: apps/v1
+  kind: Deployment
+  metadata: {"name": "helm-guestbook1", "namespace": "default1"}
+`)
+	obj2 := strToUnstructured(`
+  apiVersion: apps/v1
+  kind: Deployment
+  metadata: {"name": "helm-guestbook2", "namespace": "default2"}
+`)
+
+	cluster := newCluster(obj1, obj2)
+	cluster.cluster.Namespaces = []string{"default1", "default2"}
+	cluster.namespaceResourceFilter = func(ns string, gk schema.GroupKind) bool {
+		return ns == "default2" && gk.Kind == "Deployment"
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	assert.Len(t, cluster.nodes, 1)
+	_, ok := cluster.nodes[kube.GetResourceKey(obj1)]
+	assert.True(t, ok)
+}
+
+func TestUpdateNamespaces(t *testing.T) {
+	obj1 := strToUnstructured(`
+  apiVersion: apps/v1
+  kind: Deployment
+  metadata: {"name": "helm-guestbook1", "namespace": "default1"}
+`)
+	obj2 := strToUnstructured(`
+  apiVersion: apps/v1
+  kind: Deployment
+  metadata: {"name": "helm-guestbook2", "namespace": "default2"}
+`)
+
+	cluster := newCluster(obj1, obj2)
+	cluster.cluster.Namespaces = []string{"default1"}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	syncTime := cluster.syncTime
+
+	err = cluster.updateNamespaces([]string{"default2"})
+	assert.Nil(t, err)
+
+	cluster.lock.RLock()
+	_, obj1Cached := cluster.nodes[kube.GetResourceKey(obj1)]
+	_, obj2Cached := cluster.nodes[kube.GetResourceKey(obj2)]
+	assert.Equal(t, syncTime, cluster.syncTime)
+	cluster.lock.RUnlock()
+	assert.False(t, obj1Cached)
+	assert.True(t, obj2Cached)
+
+	// resources of the added namespace are watched
+	obj3 := obj2.DeepCopy()
+	obj3.SetName("helm-guestbook3")
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient
+	_, err = client.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}).Namespace("default2").Create(obj3, metav1.CreateOptions{})
+	assert.Nil(t, err)
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		cluster.lock.RLock()
+		defer cluster.lock.RUnlock()
+		_, ok := cluster.nodes[kube.GetResourceKey(obj3)]
+		return ok, nil
+	})
+	assert.Nil(t, err)
+}
+
+func TestUpdateNamespacesListsWithoutLock(t *testing.T) {
+	cluster := newCluster(testPod)
+	cluster.cluster.Namespaces = []string{"default"}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	var locked int32
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	client.PrependReactor("list", "*", func(action testcore.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "other" {
+			acquired := make(chan bool)
+			go func() {
+				cluster.lock.RLock()
+				defer cluster.lock.RUnlock()
+				close(acquired)
+			}()
+			select {
+			case <-acquired:
+			case <-time.After(5 * time.Second):
+				atomic.StoreInt32(&locked, 1)
+			}
+		}
+		return false, nil, nil
+	})
+
+	err = cluster.updateNamespaces([]string{"default", "other"})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&locked))
+}
+
+func TestInvalidateNamespace(t *testing.T) {
+	otherPod := testPod.DeepCopy()
+	otherPod.SetName("other-pod")
+	otherPod.SetNamespace("other")
+	otherPod.SetUID("10")
+	cluster := newCluster(testPod, testRS, testDeploy, otherPod)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	syncTime := cluster.syncTime
+	clusterRole := strToUnstructured(`
+  apiVersion: rbac.authorization.k8s.io/v1
+  kind: ClusterRole
+  metadata: {"name": "cluster-role", "uid": "11"}
+`)
+	cluster.lock.Lock()
+	cluster.setNode(cluster.createObjInfo(clusterRole, common.LabelKeyAppInstance))
+	cluster.lock.Unlock()
+
+	assert.Error(t, cluster.invalidateNamespace(""))
+	err = cluster.invalidateNamespace("default")
+	assert.Nil(t, err)
+	assert.Len(t, cluster.nodes, 2)
+	assert.Contains(t, cluster.nodes, kube.GetResourceKey(otherPod))
+	assert.Contains(t, cluster.nodes, kube.GetResourceKey(clusterRole))
+
+	err = cluster.ensureSynced()
+	assert.Nil(t, err)
+	assert.Equal(t, syncTime, cluster.syncTime)
+	assert.Len(t, cluster.nodes, 5)
+	assert.Contains(t, cluster.nodes, kube.GetResourceKey(testDeploy))
+	assert.Empty(t, cluster.invalidatedNamespaces)
+}
+
+func TestEvictKind(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	podGK := schema.GroupKind{Kind: "Pod"}
+
+	err = cluster.evictKind(podGK)
+	assert.Nil(t, err)
+	assert.NotContains(t, cluster.nodes, kube.GetResourceKey(testPod))
+	assert.NotContains(t, cluster.apisMeta, podGK)
+	assert.Len(t, cluster.nodes, 2)
+
+	// evicted kind is not listed by the full sync
+	cluster.invalidate()
+	err = cluster.ensureSynced()
+	assert.Nil(t, err)
+	assert.NotContains(t, cluster.nodes, kube.GetResourceKey(testPod))
+	assert.NotContains(t, cluster.apisMeta, podGK)
+
+	err = cluster.unevictKind(podGK)
+	assert.Nil(t, err)
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		cluster.lock.RLock()
+		defer cluster.lock.RUnlock()
+		_, ok := cluster.nodes[kube.GetResourceKey(testPod)]
+		return ok, nil
+	})
+	assert.Nil(t, err)
+	assert.Contains(t, cluster.apisMeta, podGK)
+}
+
+func TestMaxResources(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	cluster.maxResources = 3
+	var notifications [][2]int
+	cluster.onCacheOverLimit = func(count int, limit int) {
+		notifications = append(notifications, [2]int{count, limit})
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	assert.Empty(t, notifications)
+
+	otherPod := testPod.DeepCopy()
+	otherPod.SetName("other-pod")
+	otherPod.SetUID("10")
+	cluster.processEvent(watch.Added, otherPod)
+	assert.Len(t, cluster.nodes, 4)
+	assert.Equal(t, [][2]int{{4, 3}}, notifications)
+
+	// handler is not notified again until the number of resources drops below the limit
+	anotherPod := testPod.DeepCopy()
+	anotherPod.SetName("another-pod")
+	anotherPod.SetUID("11")
+	cluster.processEvent(watch.Added, anotherPod)
+	assert.Len(t, notifications, 1)
+
+	cluster.processEvent(watch.Deleted, anotherPod)
+	cluster.processEvent(watch.Deleted, otherPod)
+	cluster.processEvent(watch.Added, otherPod)
+	assert.Equal(t, [][2]int{{4, 3}, {4, 3}}, notifications)
+}
+
+func TestIncludeClusterScopedKinds(t *testing.T) {
+	clusterRole := strToUnstructured(`
+  apiVersion: rbac.authorization.k8s.io/v1
+  kind: ClusterRole
+  metadata: {"name": "cluster-role", "uid": "11"}
+`)
+	clusterRoleGK := schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}
+	newNamespacedCluster := func() *clusterInfo {
+		cluster := newCluster(testPod, clusterRole)
+		kubectl := cluster.kubectl.(*kubetest.MockKubectlCmd)
+		kubectl.APIResources = append(kubectl.APIResources, kube.APIResourceInfo{
+			GroupKind:            clusterRoleGK,
+			GroupVersionResource: schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"},
+			Meta:                 metav1.APIResource{Namespaced: false},
+		})
+		cluster.cluster.Namespaces = []string{"default"}
+		return cluster
+	}
+
+	cluster := newNamespacedCluster()
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	assert.Contains(t, cluster.nodes, kube.GetResourceKey(testPod))
+	assert.NotContains(t, cluster.nodes, kube.GetResourceKey(clusterRole))
+
+	cluster = newNamespacedCluster()
+	cluster.includeClusterScopedKinds = []schema.GroupKind{clusterRoleGK}
+	err = cluster.ensureSynced()
+	assert.Nil(t, err)
+	assert.Contains(t, cluster.nodes, kube.GetResourceKey(testPod))
+	assert.Contains(t, cluster.nsIndex[""], kube.GetResourceKey(clusterRole))
+}
+
+func TestUpdateNamespacesToClusterLevel(t *testing.T) {
+	cluster := newCluster(testPod)
+	cluster.cluster.Namespaces = []string{"default"}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	err = cluster.updateNamespaces(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, cluster.syncTime)
+	assert.Empty(t, cluster.cluster.Namespaces)
+}
+
 func TestGetNamespaceResources(t *testing.T) {
 	defaultNamespaceTopLevel1 := strToUnstructured(`
   apiVersion: apps/v1
@@ -256,6 +510,52 @@ func TestGetNamespaceResources(t *testing.T) {
 	assert.Equal(t, resources[kube.GetResourceKey(kubesystemNamespaceTopLevel2)].Name, "helm-guestbook3")
 }
 
+func TestGetNamespaces(t *testing.T) {
+	otherPod := testPod.DeepCopy()
+	otherPod.SetName("other-pod")
+	otherPod.SetNamespace("other")
+	otherPod.SetUID("10")
+	clusterRole := strToUnstructured(`
+  apiVersion: rbac.authorization.k8s.io/v1
+  kind: ClusterRole
+  metadata: {"name": "cluster-role", "uid": "11"}
+`)
+	cluster := newCluster(testPod, testRS, testDeploy, otherPod)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	cluster.lock.Lock()
+	cluster.setNode(cluster.createObjInfo(clusterRole, common.LabelKeyAppInstance))
+	cluster.lock.Unlock()
+
+	assert.Equal(t, []string{"default", "other"}, cluster.getNamespaces())
+}
+
+func TestGetResourcesByLabel(t *testing.T) {
+	otherPod := testPod.DeepCopy()
+	otherPod.SetName("other-pod")
+	otherPod.SetNamespace("other")
+	otherPod.SetUID("10")
+	otherPod.SetLabels(map[string]string{"app": "guestbook"})
+	pod := testPod.DeepCopy()
+	pod.SetLabels(map[string]string{"app": "guestbook"})
+	cluster := newCluster(pod, testRS, testDeploy, otherPod)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	selector := labels.SelectorFromSet(map[string]string{"app": "guestbook"})
+	// pods are not root application nodes, so their manifests are not cached
+	resources := cluster.getResourcesByLabel(selector, "")
+	assert.Len(t, resources, 2)
+	assert.Equal(t, "default", resources[0].Namespace)
+	assert.Equal(t, "other", resources[1].Namespace)
+
+	resources = cluster.getResourcesByLabel(selector, "other")
+	assert.Len(t, resources, 1)
+	assert.Equal(t, otherPod.GetName(), resources[0].Name)
+
+	assert.Empty(t, cluster.getResourcesByLabel(labels.SelectorFromSet(map[string]string{"app": "other"}), ""))
+}
+
 func TestGetChildren(t *testing.T) {
 	cluster := newCluster(testPod, testRS, testDeploy)
 	err := cluster.ensureSynced()
@@ -329,6 +629,208 @@ metadata:
 	})
 }
 
+// countingConversionsKubectl counts resource conversions
+type countingConversionsKubectl struct {
+	*kubetest.MockKubectlCmd
+	conversions int32
+}
+
+func (k *countingConversionsKubectl) ConvertToVersion(obj *unstructured.Unstructured, group, version string) (*unstructured.Unstructured, error) {
+	atomic.AddInt32(&k.conversions, 1)
+	return obj.DeepCopy(), nil
+}
+
+func newConversionsCountingCluster(objs ...*unstructured.Unstructured) (*clusterInfo, *countingConversionsKubectl) {
+	cluster := newCluster(objs...)
+	kubectl := &countingConversionsKubectl{MockKubectlCmd: cluster.kubectl.(*kubetest.MockKubectlCmd)}
+	cluster.kubectl = kubectl
+	return cluster, kubectl
+}
+
+var managedLiveObjsTestApp = &appv1.Application{
+	ObjectMeta: metav1.ObjectMeta{Name: "helm-guestbook"},
+	Spec: appv1.ApplicationSpec{
+		Destination: appv1.ApplicationDestination{
+			Namespace: "default",
+		},
+	},
+}
+
+func TestGetManagedLiveObjsReusesConversions(t *testing.T) {
+	cluster, kubectl := newConversionsCountingCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	targetObjs := []*unstructured.Unstructured{testDeploy.DeepCopy()}
+
+	for i := 0; i < 3; i++ {
+		managedObjs, err := cluster.getManagedLiveObjs(managedLiveObjsTestApp, targetObjs, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, testDeploy, managedObjs[kube.GetResourceKey(testDeploy)])
+		// returned objects can be modified without affecting cached conversion
+		managedObjs[kube.GetResourceKey(testDeploy)].SetName("modified")
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&kubectl.conversions))
+
+	// conversion is performed again once the resource is updated
+	deploy := testDeploy.DeepCopy()
+	deploy.SetResourceVersion("124")
+	cluster.processEvent(watch.Modified, deploy)
+	managedObjs, err := cluster.getManagedLiveObjs(managedLiveObjsTestApp, targetObjs, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "124", managedObjs[kube.GetResourceKey(testDeploy)].GetResourceVersion())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&kubectl.conversions))
+}
+
+// slowLiveQueriesKubectl tracks the maximum number of concurrently loaded resources
+type slowLiveQueriesKubectl struct {
+	*kubetest.MockKubectlCmd
+	lock        sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func (k *slowLiveQueriesKubectl) GetResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+	k.lock.Lock()
+	k.inFlight++
+	if k.inFlight > k.maxInFlight {
+		k.maxInFlight = k.inFlight
+	}
+	k.lock.Unlock()
+	time.Sleep(5 * time.Millisecond)
+	k.lock.Lock()
+	k.inFlight--
+	k.lock.Unlock()
+	un := &unstructured.Unstructured{}
+	un.SetGroupVersionKind(gvk)
+	un.SetName(name)
+	un.SetNamespace(namespace)
+	return un, nil
+}
+
+func TestSortByGroupKind(t *testing.T) {
+	pod1 := testPod.DeepCopy()
+	pod1.SetName("pod1")
+	pod2 := testPod.DeepCopy()
+	pod2.SetName("pod2")
+	objs := []*unstructured.Unstructured{testDeploy, pod1, testRS, pod2, testService}
+
+	sorted := sortByGroupKind(objs)
+	names := make([]string, len(sorted))
+	for i := range sorted {
+		names[i] = sorted[i].GetKind() + "/" + sorted[i].GetName()
+	}
+	assert.Equal(t, []string{"Pod/pod1", "Pod/pod2", "Service/helm-guestbook", "Deployment/helm-guestbook", "ReplicaSet/helm-guestbook-rs"}, names)
+	// original slice is not modified
+	assert.Equal(t, testDeploy, objs[0])
+}
+
+func TestGetManagedLiveObjsMaxConcurrentLiveQueries(t *testing.T) {
+	cluster := newCluster()
+	kubectl := &slowLiveQueriesKubectl{MockKubectlCmd: cluster.kubectl.(*kubetest.MockKubectlCmd)}
+	cluster.kubectl = kubectl
+	cluster.setMaxConcurrentLiveQueries(2)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	// config maps are not watched so every target object is loaded from the cluster
+	targetObjs := make([]*unstructured.Unstructured, 10)
+	for i := range targetObjs {
+		targetObjs[i] = strToUnstructured(fmt.Sprintf(`
+apiVersion: v1
+kind: ConfigMap
+metadata: {"name": "cm%d", "namespace": "default"}`, i))
+	}
+	managedObjs, err := cluster.getManagedLiveObjs(managedLiveObjsTestApp, targetObjs, nil)
+	assert.Nil(t, err)
+	assert.Len(t, managedObjs, len(targetObjs))
+	assert.True(t, kubectl.maxInFlight <= 2)
+}
+
+// blockingLiveQueriesKubectl blocks loading resources until released
+type blockingLiveQueriesKubectl struct {
+	*kubetest.MockKubectlCmd
+	release chan struct{}
+}
+
+func (k *blockingLiveQueriesKubectl) GetResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+	<-k.release
+	return nil, nil
+}
+
+func TestGetManagedLiveObjsContextCancelled(t *testing.T) {
+	cluster := newCluster()
+	kubectl := &blockingLiveQueriesKubectl{MockKubectlCmd: cluster.kubectl.(*kubetest.MockKubectlCmd), release: make(chan struct{})}
+	defer close(kubectl.release)
+	cluster.kubectl = kubectl
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	targetObj := strToUnstructured(`
+apiVersion: v1
+kind: ConfigMap
+metadata: {"name": "cm", "namespace": "default"}`)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = cluster.getManagedLiveObjsContext(ctx, managedLiveObjsTestApp, []*unstructured.Unstructured{targetObj}, nil)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func BenchmarkGetManagedLiveObjs(b *testing.B) {
+	cluster, kubectl := newConversionsCountingCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	if err != nil {
+		b.Fatal(err)
+	}
+	targetObjs := []*unstructured.Unstructured{testDeploy.DeepCopy()}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := cluster.getManagedLiveObjs(managedLiveObjsTestApp, targetObjs, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(atomic.LoadInt32(&kubectl.conversions))/float64(b.N), "conversions/op")
+}
+
+func TestComputeLiveTargetPatches(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	app := &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "helm-guestbook"},
+		Spec: appv1.ApplicationSpec{
+			Destination: appv1.ApplicationDestination{
+				Namespace: "default",
+			},
+		},
+	}
+	targetDeploy := strToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: helm-guestbook
+  labels:
+    app.kubernetes.io/instance: helm-guestbook
+spec:
+  replicas: 2`)
+	targetMissing := strToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: missing`)
+	targetObjs := []*unstructured.Unstructured{targetDeploy, targetMissing}
+
+	liveObjs, err := cluster.getManagedLiveObjs(app, targetObjs, nil)
+	assert.Nil(t, err)
+	patches, err := cluster.computeLiveTargetPatches(app, targetObjs, liveObjs)
+	assert.Nil(t, err)
+	assert.Len(t, patches, 1)
+	patch, ok := patches[kube.NewResourceKey("apps", "Deployment", "default", "helm-guestbook")]
+	assert.True(t, ok)
+	assert.Contains(t, string(patch), `"replicas":2`)
+}
+
 func TestChildDeletedEvent(t *testing.T) {
 	cluster := newCluster(testPod, testRS, testDeploy)
 	err := cluster.ensureSynced()
@@ -451,7 +953,7 @@ func TestUpdateResourceTags(t *testing.T) {
 func TestUpdateAppResource(t *testing.T) {
 	updatesReceived := make([]string, 0)
 	cluster := newCluster(testPod, testRS, testDeploy)
-	cluster.onObjectUpdated = func(managedByApp map[string]bool, _ corev1.ObjectReference) {
+	cluster.onObjectUpdated = func(managedByApp map[string]bool, _ corev1.ObjectReference, _ watch.EventType) {
 		for appName, fullRefresh := range managedByApp {
 			updatesReceived = append(updatesReceived, fmt.Sprintf("%s: %v", appName, fullRefresh))
 		}
@@ -486,6 +988,160 @@ func TestCircularReference(t *testing.T) {
 	assert.Equal(t, "", app)
 }
 
+func TestSnapshotHierarchy(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	snapshot := cluster.snapshotHierarchy(kube.GetResourceKey(testDeploy))
+	assert.Len(t, snapshot, 3)
+	assert.Equal(t, testDeploy.GetName(), snapshot[0].Name)
+
+	// modifications of the cache are not visible in the snapshot
+	pod := testPod.DeepCopy()
+	pod.SetResourceVersion("124")
+	cluster.processEvent(watch.Modified, pod)
+	for _, res := range snapshot {
+		if res.Kind == "Pod" {
+			assert.Equal(t, "123", res.ResourceVersion)
+		}
+	}
+
+	// modifications of the snapshot are not visible in the cache
+	snapshot[0].Info = append(snapshot[0].Info, appv1.InfoItem{Name: "foo", Value: "bar"})
+	assert.NotContains(t, cluster.snapshotHierarchy(kube.GetResourceKey(testDeploy))[0].Info, appv1.InfoItem{Name: "foo", Value: "bar"})
+}
+
+func TestIterateHierarchyTwoNodeCycle(t *testing.T) {
+	dep := testDeploy.DeepCopy()
+	dep.SetOwnerReferences([]metav1.OwnerReference{{
+		Name:       testRS.GetName(),
+		Kind:       testRS.GetKind(),
+		APIVersion: testRS.GetAPIVersion(),
+		UID:        testRS.GetUID(),
+	}})
+	cluster := newCluster(testRS, dep)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	count := 0
+	cluster.iterateHierarchy(kube.GetResourceKey(dep), func(child appv1.ResourceNode, appName string) {
+		count++
+	})
+	// deployment itself and replica set; the deployment is not visited again as a child of the replica set
+	assert.Equal(t, 2, count)
+}
+
+func TestIterateHierarchyV2(t *testing.T) {
+	// the pod is owned by both replica sets
+	otherRS := testRS.DeepCopy()
+	otherRS.SetName("other-rs")
+	otherRS.SetUID("5")
+	otherRS.SetOwnerReferences(nil)
+	pod := testPod.DeepCopy()
+	pod.SetOwnerReferences(append(pod.GetOwnerReferences(), metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+		Name:       otherRS.GetName(),
+		UID:        otherRS.GetUID(),
+	}))
+	cluster := newCluster(pod, testRS, testDeploy, otherRS)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	var visited []string
+	cluster.iterateHierarchyV2([]kube.ResourceKey{kube.GetResourceKey(testDeploy), kube.GetResourceKey(otherRS)}, func(child appv1.ResourceNode, appName string) bool {
+		visited = append(visited, child.Name)
+		return true
+	})
+	assert.Equal(t, []string{testDeploy.GetName(), testRS.GetName(), pod.GetName(), otherRS.GetName()}, visited)
+
+	// children of the replica set are pruned
+	visited = nil
+	cluster.iterateHierarchyV2([]kube.ResourceKey{kube.GetResourceKey(testDeploy)}, func(child appv1.ResourceNode, appName string) bool {
+		visited = append(visited, child.Name)
+		return child.Kind != "ReplicaSet"
+	})
+	assert.Equal(t, []string{testDeploy.GetName(), testRS.GetName()}, visited)
+}
+
+func TestEndpointsGroupedUnderSameNamespaceService(t *testing.T) {
+	endpoints := strToUnstructured(`
+  apiVersion: v1
+  kind: Endpoints
+  metadata:
+    name: helm-guestbook
+    namespace: default
+    uid: "5"`)
+	otherService := testService.DeepCopy()
+	otherService.SetNamespace("other")
+	otherService.SetUID("6")
+
+	cluster := newCluster()
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	cluster.lock.Lock()
+	// add the service from other namespace first, so it is the first candidate to backfill the inferred owner reference
+	for _, un := range []*unstructured.Unstructured{otherService, endpoints, testService} {
+		cluster.setNode(cluster.createObjInfo(un, common.LabelKeyAppInstance))
+	}
+	// endpoints cached after the service are resolved immediately
+	otherEndpoints := endpoints.DeepCopy()
+	otherEndpoints.SetNamespace("other")
+	otherEndpoints.SetUID("7")
+	cluster.setNode(cluster.createObjInfo(otherEndpoints, common.LabelKeyAppInstance))
+	cluster.lock.Unlock()
+
+	// inferred owner references are resolved when nodes are cached rather than while iterating the hierarchy
+	assert.Equal(t, types.UID("4"), cluster.nodes[kube.GetResourceKey(endpoints)].ownerRefs[0].UID)
+	assert.Equal(t, types.UID("6"), cluster.nodes[kube.GetResourceKey(otherEndpoints)].ownerRefs[0].UID)
+
+	otherChildren := getChildren(cluster, otherService)
+	assert.Len(t, otherChildren, 1)
+	assert.Equal(t, "other", otherChildren[0].Namespace)
+	children := getChildren(cluster, testService)
+	assert.Len(t, children, 1)
+	assert.Equal(t, "Endpoints", children[0].Kind)
+	assert.Equal(t, "default", children[0].Namespace)
+
+	endpointsNode := cluster.nodes[kube.GetResourceKey(endpoints)]
+	otherServiceNode := cluster.nodes[kube.GetResourceKey(otherService)]
+	assert.False(t, otherServiceNode.isParentOf(endpointsNode))
+	assert.Equal(t, types.UID("4"), endpointsNode.ownerRefs[0].UID)
+}
+
+func TestSyntheticOwnership(t *testing.T) {
+	endpoints := strToUnstructured(`
+  apiVersion: v1
+  kind: Endpoints
+  metadata: {"name": "helm-guestbook", "namespace": "default", "uid": "5"}
+`)
+	tokenSecret := strToUnstructured(`
+  apiVersion: v1
+  kind: Secret
+  type: kubernetes.io/service-account-token
+  metadata:
+    name: default-token
+    namespace: default
+    uid: "6"
+    annotations:
+      kubernetes.io/service-account.name: default
+      kubernetes.io/service-account.uid: "7"
+`)
+	cluster := newCluster()
+	assert.Len(t, cluster.createObjInfo(endpoints, common.LabelKeyAppInstance).ownerRefs, 1)
+	assert.Len(t, cluster.createObjInfo(tokenSecret, common.LabelKeyAppInstance).ownerRefs, 1)
+
+	cluster.skipEndpointsOwnership = true
+	assert.Empty(t, cluster.createObjInfo(endpoints, common.LabelKeyAppInstance).ownerRefs)
+	assert.Len(t, cluster.createObjInfo(tokenSecret, common.LabelKeyAppInstance).ownerRefs, 1)
+
+	cluster.skipEndpointsOwnership = false
+	cluster.skipServiceAccountTokenOwnership = true
+	assert.Len(t, cluster.createObjInfo(endpoints, common.LabelKeyAppInstance).ownerRefs, 1)
+	assert.Empty(t, cluster.createObjInfo(tokenSecret, common.LabelKeyAppInstance).ownerRefs)
+}
+
 func TestWatchCacheUpdated(t *testing.T) {
 	removed := testPod.DeepCopy()
 	removed.SetName(testPod.GetName() + "-removed-pod")
@@ -556,3 +1212,2331 @@ func TestGetDuplicatedChildren(t *testing.T) {
 		assert.Equal(t, testRS.GetName(), children[0].Name)
 	}
 }
+
+func TestDumpWatchConfig(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	cluster.cluster.Namespaces = []string{"default"}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	config := cluster.dumpWatchConfig()
+	assert.Len(t, config.Kinds, 3)
+	assert.Equal(t, schema.GroupKind{Group: "", Kind: "Pod"}, config.Kinds[0].GroupKind)
+	assert.Equal(t, schema.GroupKind{Group: "apps", Kind: "Deployment"}, config.Kinds[1].GroupKind)
+	assert.Equal(t, schema.GroupKind{Group: "apps", Kind: "ReplicaSet"}, config.Kinds[2].GroupKind)
+	for _, kind := range config.Kinds {
+		assert.True(t, kind.Namespaced)
+		assert.Equal(t, []string{"default"}, kind.Namespaces)
+	}
+}
+
+func TestGetManagedLiveObjsPreferCachedNodes(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	cluster.preferCachedNodes = true
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	targetRS := strToUnstructured(`
+apiVersion: apps/v1
+kind: ReplicaSet
+metadata:
+  name: helm-guestbook-rs`)
+
+	managedObjs, err := cluster.getManagedLiveObjs(&appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "helm-guestbook"},
+		Spec: appv1.ApplicationSpec{
+			Destination: appv1.ApplicationDestination{
+				Namespace: "default",
+			},
+		},
+	}, []*unstructured.Unstructured{targetRS}, nil)
+	assert.Nil(t, err)
+
+	rs := managedObjs[kube.GetResourceKey(testRS)]
+	assert.NotNil(t, rs)
+	assert.Equal(t, testRS.GetUID(), rs.GetUID())
+	assert.Equal(t, testRS.GetResourceVersion(), rs.GetResourceVersion())
+	assert.Equal(t, testRS.GetOwnerReferences(), rs.GetOwnerReferences())
+}
+
+func TestGetStaleResources(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	assert.Empty(t, cluster.getStaleResources(time.Minute))
+
+	cluster.lock.Lock()
+	for _, n := range cluster.nodes {
+		n.lastUpdated = time.Now().Add(-2 * time.Minute)
+	}
+	cluster.lock.Unlock()
+
+	// updated resource is not stale
+	pod := testPod.DeepCopy()
+	pod.SetResourceVersion("124")
+	cluster.processEvent(watch.Modified, pod)
+	assert.Equal(t, []kube.ResourceKey{kube.GetResourceKey(testDeploy), kube.GetResourceKey(testRS)}, cluster.getStaleResources(time.Minute))
+
+	// bookmark confirms that resources of the kind are up to date
+	cluster.recordBookmark(cluster.apisMeta[schema.GroupKind{Group: "apps", Kind: "Deployment"}], "", "125")
+	assert.Equal(t, []kube.ResourceKey{kube.GetResourceKey(testRS)}, cluster.getStaleResources(time.Minute))
+}
+
+func TestMaxObjectSize(t *testing.T) {
+	largeDeploy := testDeploy.DeepCopy()
+	largeDeploy.SetName("large-deploy")
+	largeDeploy.SetUID("10")
+	largeDeploy.SetAnnotations(map[string]string{"large": strings.Repeat("x", 1024)})
+	cluster := newCluster(testDeploy, largeDeploy)
+	cluster.maxObjectSize = 1024
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	assert.NotNil(t, cluster.nodes[kube.GetResourceKey(testDeploy)].resource)
+	large := cluster.nodes[kube.GetResourceKey(largeDeploy)]
+	assert.Nil(t, large.resource)
+	assert.Equal(t, "helm-guestbook", large.appName)
+	assert.Equal(t, []kube.ResourceKey{kube.GetResourceKey(largeDeploy)}, cluster.getOversizedResources())
+}
+
+func TestSyncPreservesNodeInfo(t *testing.T) {
+	cluster := newCluster(testPod)
+	cluster.preserveNodeInfo = true
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	key := kube.GetResourceKey(testPod)
+	cachedInfo := []appv1.InfoItem{{Name: "Cached", Value: "true"}}
+	cluster.nodes[key].info = cachedInfo
+
+	cluster.syncTime = nil
+	err = cluster.ensureSynced()
+	assert.Nil(t, err)
+	assert.Equal(t, cachedInfo, cluster.nodes[key].info)
+
+	cluster.preserveNodeInfo = false
+	cluster.syncTime = nil
+	err = cluster.ensureSynced()
+	assert.Nil(t, err)
+	assert.Equal(t, []appv1.InfoItem{{Name: "Containers", Value: "0/0"}}, cluster.nodes[key].info)
+}
+
+func TestLazyResourceInfo(t *testing.T) {
+	cluster := newCluster(testPod)
+	cluster.lazyResourceInfo = true
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	key := kube.GetResourceKey(testPod)
+	podNode := cluster.nodes[key]
+	assert.Nil(t, podNode.info)
+	assert.NotNil(t, podNode.unpopulated)
+
+	res, ok := cluster.getResource(key)
+	assert.True(t, ok)
+	assert.Equal(t, []appv1.InfoItem{{Name: "Containers", Value: "0/0"}}, res.Info)
+	assert.Equal(t, []appv1.InfoItem{{Name: "Containers", Value: "0/0"}}, podNode.info)
+	assert.Nil(t, podNode.unpopulated)
+}
+
+func TestReplaceResourceCacheNotifiesRemovedInBulk(t *testing.T) {
+	pod1 := testPod.DeepCopy()
+	pod1.SetName("pod1")
+	pod2 := testPod.DeepCopy()
+	pod2.SetName("pod2")
+
+	cluster := newCluster(pod1, pod2)
+	var removedRefs []corev1.ObjectReference
+	bulkNotifications := 0
+	cluster.onObjectsRemoved = func(refs []corev1.ObjectReference) {
+		bulkNotifications++
+		removedRefs = append(removedRefs, refs...)
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	cluster.replaceResourceCache(testPod.GroupVersionKind().GroupKind(), "", nil, "")
+
+	assert.Equal(t, 1, bulkNotifications)
+	assert.Len(t, removedRefs, 2)
+	assert.Len(t, cluster.nodes, 0)
+}
+
+func TestObjectUpdatedEventType(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	events := make([]watch.EventType, 0)
+	cluster.onObjectUpdated = func(_ map[string]bool, _ corev1.ObjectReference, event watch.EventType) {
+		events = append(events, event)
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	newPod := testPod.DeepCopy()
+	newPod.SetName("new-pod")
+	cluster.processEvent(watch.Added, newPod)
+	cluster.processEvent(watch.Modified, newPod)
+	cluster.processEvent(watch.Deleted, newPod)
+
+	assert.Equal(t, []watch.EventType{watch.Added, watch.Modified, watch.Deleted}, events)
+}
+
+func TestObjectRemovedHandler(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	events := make([]watch.EventType, 0)
+	cluster.onObjectUpdated = func(_ map[string]bool, _ corev1.ObjectReference, event watch.EventType) {
+		events = append(events, event)
+	}
+	removed := make([]string, 0)
+	cluster.onObjectRemoved = func(_ map[string]bool, ref corev1.ObjectReference) {
+		removed = append(removed, ref.Name)
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	newPod := testPod.DeepCopy()
+	newPod.SetName("new-pod")
+	cluster.processEvent(watch.Added, newPod)
+	cluster.processEvent(watch.Deleted, newPod)
+
+	assert.Equal(t, []watch.EventType{watch.Added}, events)
+	assert.Equal(t, []string{"new-pod"}, removed)
+}
+
+type failingDynamicClientKubectl struct {
+	*kubetest.MockKubectlCmd
+	failures int
+}
+
+func (k *failingDynamicClientKubectl) NewDynamicClient(config *rest.Config) (dynamic.Interface, error) {
+	if k.failures > 0 {
+		k.failures--
+		return nil, fmt.Errorf("failed to create dynamic client")
+	}
+	return k.MockKubectlCmd.NewDynamicClient(config)
+}
+
+func TestRetryStartMissingWatches(t *testing.T) {
+	backoff := startMissingWatchesBackoff
+	startMissingWatchesBackoff = wait.Backoff{Steps: 5, Duration: time.Millisecond, Factor: 1}
+	defer func() {
+		startMissingWatchesBackoff = backoff
+	}()
+
+	kubectl := &failingDynamicClientKubectl{MockKubectlCmd: &kubetest.MockKubectlCmd{
+		DynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme()),
+		APIResources: []kube.APIResourceInfo{{
+			GroupKind:            schema.GroupKind{Group: "", Kind: "Pod"},
+			GroupVersionResource: schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"},
+			Meta:                 metav1.APIResource{Namespaced: true},
+		}},
+	}}
+	cluster := newClusterExt(kubectl)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	serviceGK := schema.GroupKind{Group: "", Kind: "Service"}
+	kubectl.APIResources = append(kubectl.APIResources, kube.APIResourceInfo{
+		GroupKind:            serviceGK,
+		GroupVersionResource: schema.GroupVersionResource{Group: "", Version: "v1", Resource: "services"},
+		Meta:                 metav1.APIResource{Namespaced: true},
+	})
+	kubectl.failures = 2
+
+	cluster.retryStartMissingWatches(context.Background())
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+	_, ok := cluster.apisMeta[serviceGK]
+	assert.True(t, ok)
+	assert.Equal(t, 0, kubectl.failures)
+}
+
+func TestChangesSince(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	keys, token, err := cluster.changesSince("")
+	assert.Nil(t, err)
+	assert.Len(t, keys, 3)
+
+	keys, token, err = cluster.changesSince(token)
+	assert.Nil(t, err)
+	assert.Len(t, keys, 0)
+
+	cluster.processEvent(watch.Deleted, testPod)
+	keys, _, err = cluster.changesSince(token)
+	assert.Nil(t, err)
+	assert.Equal(t, []kube.ResourceKey{kube.GetResourceKey(testPod)}, keys)
+
+	cluster.syncTime = nil
+	err = cluster.ensureSynced()
+	assert.Nil(t, err)
+	_, _, err = cluster.changesSince(token)
+	assert.Error(t, err)
+
+	_, _, err = cluster.changesSince("invalid")
+	assert.Error(t, err)
+}
+
+func TestGetCacheGeneration(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	generation := cluster.getCacheGeneration()
+	assert.Equal(t, generation, cluster.getCacheGeneration())
+
+	pod := testPod.DeepCopy()
+	pod.SetResourceVersion("124")
+	cluster.processEvent(watch.Modified, pod)
+	assert.True(t, cluster.getCacheGeneration() > generation)
+
+	generation = cluster.getCacheGeneration()
+	cluster.processEvent(watch.Deleted, pod)
+	assert.True(t, cluster.getCacheGeneration() > generation)
+}
+
+func TestTrimObjectMeta(t *testing.T) {
+	deploy := testDeploy.DeepCopy()
+	deploy.SetAnnotations(map[string]string{corev1.LastAppliedConfigAnnotation: "{}", "foo": "bar"})
+	cluster := newCluster(deploy)
+	cluster.trimObjectMeta = true
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	node := cluster.nodes[kube.GetResourceKey(deploy)]
+	assert.NotNil(t, node.resource)
+	assert.Equal(t, map[string]string{"foo": "bar"}, node.resource.GetAnnotations())
+}
+
+func TestQueryResources(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	resources := cluster.queryResources(func(un *unstructured.Unstructured) bool {
+		return un.GetKind() == kube.DeploymentKind
+	})
+	assert.Len(t, resources, 1)
+	assert.Equal(t, testDeploy.GetName(), resources[0].Name)
+
+	// pods and replica sets have no cached manifest
+	resources = cluster.queryResources(func(un *unstructured.Unstructured) bool {
+		return true
+	})
+	assert.Len(t, resources, 1)
+}
+
+func TestSelfReferencingOwnerRef(t *testing.T) {
+	deploy := testDeploy.DeepCopy()
+	deploy.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion: deploy.GetAPIVersion(),
+		Kind:       deploy.GetKind(),
+		Name:       deploy.GetName(),
+		UID:        deploy.GetUID(),
+	}})
+	cluster := newCluster(deploy, testRS, testPod)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	resources := cluster.getNamespaceTopLevelResources("default")
+	assert.Len(t, resources, 1)
+	assert.Contains(t, resources, kube.GetResourceKey(deploy))
+
+	children := getChildren(cluster, deploy)
+	assert.Len(t, children, 2)
+	for _, child := range children {
+		assert.NotEqual(t, deploy.GetName(), child.Name)
+	}
+}
+
+type failingAPIResourcesKubectl struct {
+	*kubetest.MockKubectlCmd
+	err error
+}
+
+func (k *failingAPIResourcesKubectl) GetAPIResources(config *rest.Config, resourceFilter kube.ResourceFilter) ([]kube.APIResourceInfo, error) {
+	if k.err != nil {
+		return nil, k.err
+	}
+	return k.MockKubectlCmd.GetAPIResources(config, resourceFilter)
+}
+
+func TestSyncedRespectsConfiguredTimeouts(t *testing.T) {
+	cluster := newCluster()
+	syncTime := time.Now().Add(-time.Hour)
+	cluster.syncTime = &syncTime
+	assert.True(t, cluster.synced())
+
+	cluster.syncTimeout = 30 * time.Minute
+	assert.False(t, cluster.synced())
+	cluster.syncTimeout = 2 * time.Hour
+	assert.True(t, cluster.synced())
+
+	cluster.syncError = fmt.Errorf("connection refused")
+	syncTime = time.Now().Add(-time.Minute)
+	assert.False(t, cluster.synced())
+	cluster.retryTimeout = 5 * time.Minute
+	assert.True(t, cluster.synced())
+}
+
+// blockingAPIResourcesKubectl blocks API resources discovery until released
+type blockingAPIResourcesKubectl struct {
+	*kubetest.MockKubectlCmd
+	started chan struct{}
+	release chan struct{}
+}
+
+func (k *blockingAPIResourcesKubectl) GetAPIResources(config *rest.Config, resourceFilter kube.ResourceFilter) ([]kube.APIResourceInfo, error) {
+	close(k.started)
+	<-k.release
+	return nil, fmt.Errorf("connection refused")
+}
+
+func TestGetSyncStatus(t *testing.T) {
+	cluster := newCluster(testPod)
+	assert.Equal(t, NotSynced, cluster.getSyncStatus())
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	assert.Equal(t, Synced, cluster.getSyncStatus())
+
+	kubectl := &blockingAPIResourcesKubectl{
+		MockKubectlCmd: cluster.kubectl.(*kubetest.MockKubectlCmd),
+		started:        make(chan struct{}),
+		release:        make(chan struct{}),
+	}
+	cluster.kubectl = kubectl
+	cluster.syncTime = nil
+	done := make(chan error)
+	go func() {
+		done <- cluster.ensureSynced()
+	}()
+	<-kubectl.started
+	assert.Equal(t, Syncing, cluster.getSyncStatus())
+	close(kubectl.release)
+	assert.NotNil(t, <-done)
+	assert.Equal(t, SyncFailed, cluster.getSyncStatus())
+}
+
+func TestMaxSyncRetries(t *testing.T) {
+	kubectl := &failingAPIResourcesKubectl{MockKubectlCmd: &kubetest.MockKubectlCmd{DynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme())}}
+	kubectl.err = fmt.Errorf("connection refused")
+	cluster := newClusterExt(kubectl)
+	cluster.maxSyncRetries = 2
+	// makes the failed sync eligible for retry
+	expireSync := func() {
+		syncTime := time.Now().Add(-clusterRetryTimeout)
+		cluster.syncTime = &syncTime
+	}
+
+	for i := 0; i < 2; i++ {
+		expireSync()
+		err := cluster.ensureSynced()
+		assert.NotNil(t, err)
+		assert.False(t, goerrors.Is(err, ErrSyncRetriesExhausted))
+	}
+
+	// sync is not retried even if the cluster becomes reachable
+	kubectl.err = nil
+	expireSync()
+	err := cluster.ensureSynced()
+	assert.True(t, goerrors.Is(err, ErrSyncRetriesExhausted))
+	assert.Contains(t, err.Error(), "connection refused")
+	assert.Equal(t, SyncFailed, cluster.getSyncStatus())
+
+	cluster.invalidate()
+	err = cluster.ensureSynced()
+	assert.Nil(t, err)
+	assert.Equal(t, Synced, cluster.getSyncStatus())
+}
+
+func TestSyncStateChanged(t *testing.T) {
+	kubectl := &failingAPIResourcesKubectl{MockKubectlCmd: &kubetest.MockKubectlCmd{DynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme())}}
+	cluster := newClusterExt(kubectl)
+	transitions := make([]bool, 0)
+	cluster.onSyncStateChanged = func(nowHealthy bool, err error) {
+		transitions = append(transitions, nowHealthy)
+	}
+
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	kubectl.err = fmt.Errorf("connection refused")
+	for i := 0; i < 2; i++ {
+		cluster.syncTime = nil
+		err = cluster.ensureSynced()
+		assert.NotNil(t, err)
+	}
+
+	kubectl.err = nil
+	cluster.syncTime = nil
+	err = cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	assert.Equal(t, []bool{false, true}, transitions)
+}
+
+func TestSyncCompleted(t *testing.T) {
+	kubectl := &failingAPIResourcesKubectl{MockKubectlCmd: &kubetest.MockKubectlCmd{DynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme())}}
+	cluster := newClusterExt(kubectl)
+	syncErrors := make([]error, 0)
+	cluster.onSyncCompleted = func(err error) {
+		// cache must not be locked while handler is executed
+		cluster.getClusterInfo()
+		syncErrors = append(syncErrors, err)
+	}
+
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	// cache is already synced
+	err = cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	kubectl.err = fmt.Errorf("connection refused")
+	cluster.syncTime = nil
+	err = cluster.ensureSynced()
+	assert.NotNil(t, err)
+
+	assert.Len(t, syncErrors, 2)
+	assert.Nil(t, syncErrors[0])
+	assert.Equal(t, err, syncErrors[1])
+}
+
+func TestControllerOwnerRefsOnly(t *testing.T) {
+	controller := true
+	pod := testPod.DeepCopy()
+	pod.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+		Name:       "helm-guestbook-rs",
+		UID:        "2",
+		Controller: &controller,
+	}})
+	rs := testRS.DeepCopy()
+	// replica set owner reference is not marked as controller
+	cluster := newCluster(pod, rs, testDeploy)
+	cluster.controllerOwnerRefsOnly = true
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	children := cluster.getControllerChildren(kube.GetResourceKey(rs))
+	assert.Len(t, children, 1)
+	assert.Equal(t, pod.GetName(), children[0].Name)
+
+	assert.Empty(t, cluster.getControllerChildren(kube.GetResourceKey(testDeploy)))
+	assert.Empty(t, getChildren(cluster, testDeploy))
+	resources := cluster.getNamespaceTopLevelResources("default")
+	assert.Contains(t, resources, kube.GetResourceKey(rs))
+}
+
+func TestOwnerResolver(t *testing.T) {
+	pod := testPod.DeepCopy()
+	pod.SetOwnerReferences(nil)
+	pod.SetLabels(map[string]string{"parent-deployment": "helm-guestbook"})
+	cluster := newCluster(pod, testRS, testDeploy)
+	cluster.ownerResolver = func(un *unstructured.Unstructured) []metav1.OwnerReference {
+		if name, ok := un.GetLabels()["parent-deployment"]; ok {
+			return []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: name}}
+		}
+		return nil
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	children := getChildren(cluster, testDeploy)
+	assert.Len(t, children, 2)
+	assert.NotContains(t, cluster.getNamespaceTopLevelResources("default"), kube.GetResourceKey(pod))
+
+	// built-in owner references are preserved
+	endpoints := strToUnstructured(`
+  apiVersion: v1
+  kind: Endpoints
+  metadata: {"name": "helm-guestbook", "namespace": "default", "uid": "10", "labels": {"parent-deployment": "helm-guestbook"}}
+`)
+	n := cluster.createObjInfo(endpoints, common.LabelKeyAppInstance)
+	assert.Len(t, n.ownerRefs, 2)
+	assert.Equal(t, kube.ServiceKind, n.ownerRefs[0].Kind)
+	assert.Equal(t, "Deployment", n.ownerRefs[1].Kind)
+}
+
+func TestGroupKindNormalizer(t *testing.T) {
+	pod := testPod.DeepCopy()
+	pod.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "extensions/v1beta1", Kind: "ReplicaSet", Name: "helm-guestbook-rs", UID: "2"}})
+	cluster := newCluster(pod, testRS, testDeploy)
+	cluster.groupKindNormalizer = func(gk schema.GroupKind) schema.GroupKind {
+		if gk.Group == "extensions" && gk.Kind == kube.ReplicaSetKind {
+			return schema.GroupKind{Group: "apps", Kind: gk.Kind}
+		}
+		return gk
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	// owner referenced using the legacy group is resolved by the canonical key
+	assert.Equal(t, "helm-guestbook", cluster.nodes[kube.GetResourceKey(pod)].getApp(cluster.nsIndex["default"]))
+	assert.Equal(t, "apps/v1beta1", cluster.nodes[kube.GetResourceKey(pod)].ownerRefs[0].APIVersion)
+
+	// the same replicaset served by the extensions group is cached once
+	legacyRS := testRS.DeepCopy()
+	legacyRS.SetAPIVersion("extensions/v1beta1")
+	cluster.processEvent(watch.Added, legacyRS)
+	assert.Len(t, cluster.nodes, 3)
+	assert.NotContains(t, cluster.nodes, kube.GetResourceKey(legacyRS))
+
+	children := getChildren(cluster, testDeploy)
+	assert.Len(t, children, 2)
+
+	cluster.processEvent(watch.Deleted, legacyRS)
+	assert.NotContains(t, cluster.nodes, kube.GetResourceKey(testRS))
+}
+
+func TestGroupKindNormalizerAliasedTarget(t *testing.T) {
+	controller := true
+	rs := testRS.DeepCopy()
+	rs.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: kube.DeploymentKind, Name: "helm-guestbook", UID: "3", Controller: &controller}})
+	cluster := newCluster(testPod, rs, testDeploy)
+	cluster.groupKindNormalizer = func(gk schema.GroupKind) schema.GroupKind {
+		if gk.Group == "extensions" && gk.Kind == kube.DeploymentKind {
+			return schema.GroupKind{Group: "apps", Kind: gk.Kind}
+		}
+		return gk
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	targetDeploy := strToUnstructured(`
+apiVersion: extensions/v1beta1
+kind: Deployment
+metadata:
+  name: helm-guestbook
+  namespace: default`)
+	targetKey := kube.GetResourceKey(targetDeploy)
+
+	// the cached deployment is returned under the key of the target rather than reported as an extra resource
+	managedObjs, err := cluster.getManagedLiveObjs(managedLiveObjsTestApp, []*unstructured.Unstructured{targetDeploy}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, map[kube.ResourceKey]*unstructured.Unstructured{targetKey: testDeploy}, managedObjs)
+
+	hierarchy := make([]kube.ResourceKey, 0)
+	cluster.iterateHierarchy(targetKey, func(child appv1.ResourceNode, appName string) {
+		hierarchy = append(hierarchy, kube.NewResourceKey(child.Group, child.Kind, child.Namespace, child.Name))
+	})
+	assert.Equal(t, []kube.ResourceKey{kube.GetResourceKey(testDeploy), kube.GetResourceKey(rs), kube.GetResourceKey(testPod)}, hierarchy)
+
+	res, ok := cluster.getResource(targetKey)
+	assert.True(t, ok)
+	assert.Equal(t, "apps", res.Group)
+	children := cluster.getControllerChildren(targetKey)
+	if assert.Len(t, children, 1) {
+		assert.Equal(t, rs.GetName(), children[0].Name)
+	}
+}
+
+func TestHealthOverride(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	cluster.healthOverride = health.CompositeHealthOverride{
+		health.HealthOverrideFunc(func(un *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+			return nil, nil
+		}),
+		health.HealthOverrideFunc(func(un *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+			if un.GetKind() == kube.DeploymentKind {
+				return &appv1.HealthStatus{Status: appv1.HealthStatusSuspended}, nil
+			}
+			return nil, nil
+		}),
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	deploy, ok := cluster.getResource(kube.GetResourceKey(testDeploy))
+	assert.True(t, ok)
+	assert.Equal(t, appv1.HealthStatusSuspended, deploy.Health.Status)
+	rs, ok := cluster.getResource(kube.GetResourceKey(testRS))
+	assert.True(t, ok)
+	assert.True(t, rs.Health == nil || rs.Health.Status != appv1.HealthStatusSuspended)
+}
+
+func TestExportImportWatchStatus(t *testing.T) {
+	cluster := newCluster()
+	podGK := schema.GroupKind{Kind: "Pod"}
+	cluster.recordWatchFailure(podGK, apierr.NewForbidden(schema.GroupResource{Resource: "pods"}, "", fmt.Errorf("forbidden")))
+	cluster.recordWatchFailure(podGK, fmt.Errorf("connection refused"))
+
+	data, err := cluster.exportWatchStatus()
+	assert.Nil(t, err)
+
+	restored := newCluster()
+	err = restored.importWatchStatus(data)
+	assert.Nil(t, err)
+
+	statuses := restored.getWatchStatus()
+	assert.Len(t, statuses, 1)
+	assert.Equal(t, podGK, statuses[0].GroupKind)
+	assert.Equal(t, 2, statuses[0].Restarts)
+	assert.Equal(t, "connection refused", statuses[0].LastError)
+	assert.False(t, statuses[0].Forbidden)
+}
+
+func TestIsNamespacedKnown(t *testing.T) {
+	cluster := newCluster(testPod)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	cluster.lock.Lock()
+	cluster.apisMeta[schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}] = &apiMeta{namespaced: false, watchCancel: func() {}}
+	cluster.lock.Unlock()
+
+	namespaced, known := cluster.isNamespacedKnown(schema.GroupKind{Kind: "Pod"})
+	assert.True(t, namespaced)
+	assert.True(t, known)
+
+	namespaced, known = cluster.isNamespacedKnown(schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"})
+	assert.False(t, namespaced)
+	assert.True(t, known)
+
+	unknownGK := schema.GroupKind{Group: "example.com", Kind: "Unknown"}
+	namespaced, known = cluster.isNamespacedKnown(unknownGK)
+	assert.False(t, namespaced)
+	assert.False(t, known)
+	assert.True(t, cluster.isNamespaced(unknownGK))
+}
+
+func TestIsWatchHealthy(t *testing.T) {
+	cluster := newCluster()
+	podGK := schema.GroupKind{Group: "", Kind: "Pod"}
+	assert.False(t, cluster.isWatchHealthy(podGK))
+
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return cluster.isWatchHealthy(podGK), nil
+	})
+	assert.Nil(t, err)
+	assert.False(t, cluster.isWatchHealthy(schema.GroupKind{Group: "", Kind: "ConfigMap"}))
+
+	// recently failed watch is not healthy even if it has been re-established
+	cluster.recordWatchFailure(podGK, fmt.Errorf("connection refused"))
+	assert.False(t, cluster.isWatchHealthy(podGK))
+
+	cluster.lock.Lock()
+	failedAt := time.Now().Add(-2 * unhealthyWatchPeriod)
+	cluster.watchStatus[podGK].LastErrorTime = &failedAt
+	cluster.lock.Unlock()
+	assert.True(t, cluster.isWatchHealthy(podGK))
+}
+
+func TestGetKindStatuses(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	client.PrependReactor("list", "pods", func(action testcore.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("pods are not available")
+	})
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	deployGK := schema.GroupKind{Group: "apps", Kind: "Deployment"}
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return cluster.getKindStatuses()[deployGK].Watching, nil
+	})
+	assert.Nil(t, err)
+
+	statuses := cluster.getKindStatuses()
+	assert.Len(t, statuses, 3)
+	assert.True(t, statuses[deployGK].Listed)
+	assert.Empty(t, statuses[deployGK].LastError)
+	podStatus := statuses[schema.GroupKind{Kind: "Pod"}]
+	assert.False(t, podStatus.Listed)
+	assert.False(t, podStatus.Watching)
+	assert.Contains(t, podStatus.LastError, "pods are not available")
+}
+
+func TestSubscribeEvents(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	events, unsubscribe := cluster.subscribeEvents(2, DropNewestEvent)
+
+	cluster.processEvent(watch.Modified, testPod)
+	cluster.processEvent(watch.Deleted, testPod)
+	cluster.processEvent(watch.Added, testPod)
+
+	event := <-events
+	assert.Equal(t, watch.Modified, event.Type)
+	assert.Equal(t, testPod.GetName(), event.New.Name)
+	assert.Equal(t, testPod.GetName(), event.Old.Name)
+	event = <-events
+	assert.Equal(t, watch.Deleted, event.Type)
+	assert.Nil(t, event.New)
+	assert.Equal(t, 1, cluster.droppedEvents())
+
+	unsubscribe()
+	_, ok := <-events
+	assert.False(t, ok)
+	// events dropped by the former subscriber are still counted
+	assert.Equal(t, 1, cluster.droppedEvents())
+}
+
+func TestSubscribeEventsDropOldest(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	events, unsubscribe := cluster.subscribeEvents(2, DropOldestEvent)
+	defer unsubscribe()
+
+	cluster.processEvent(watch.Modified, testPod)
+	cluster.processEvent(watch.Deleted, testPod)
+	cluster.processEvent(watch.Added, testPod)
+
+	event := <-events
+	assert.Equal(t, watch.Deleted, event.Type)
+	event = <-events
+	assert.Equal(t, watch.Added, event.Type)
+	assert.Equal(t, 1, cluster.droppedEvents())
+}
+
+func TestEnsureSyncedContextCancelled(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := cluster.ensureSyncedContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, cluster.syncTime)
+
+	err = cluster.ensureSyncedContext(context.Background())
+	assert.Nil(t, err)
+	assert.NotNil(t, cluster.syncTime)
+	assert.Len(t, cluster.nodes, 3)
+}
+
+func TestIsCRDEstablished(t *testing.T) {
+	crd := strToUnstructured(`
+  apiVersion: apiextensions.k8s.io/v1beta1
+  kind: CustomResourceDefinition
+  metadata:
+    name: foos.example.com
+  spec:
+    group: example.com
+    names:
+      kind: Foo
+  status:
+    conditions:
+    - type: NamesAccepted
+      status: "True"`)
+	gk, ok := crdGroupKind(crd)
+	assert.True(t, ok)
+	assert.Equal(t, schema.GroupKind{Group: "example.com", Kind: "Foo"}, gk)
+	assert.False(t, isCRDEstablished(crd))
+
+	conditions, _, _ := unstructured.NestedSlice(crd.Object, "status", "conditions")
+	conditions = append(conditions, map[string]interface{}{"type": "Established", "status": "True"})
+	assert.Nil(t, unstructured.SetNestedSlice(crd.Object, conditions, "status", "conditions"))
+	assert.True(t, isCRDEstablished(crd))
+}
+
+func TestCRDAPIResourceInfo(t *testing.T) {
+	crd := strToUnstructured(`
+  apiVersion: apiextensions.k8s.io/v1beta1
+  kind: CustomResourceDefinition
+  metadata:
+    name: foos.example.com
+  spec:
+    group: example.com
+    scope: Namespaced
+    names:
+      kind: Foo
+      plural: foos
+    versions:
+    - name: v1alpha1
+      served: true
+      storage: false
+    - name: v1beta1
+      served: true
+      storage: true
+    - name: v1
+      served: false
+      storage: false`)
+	api, ok := crdAPIResourceInfo(crd)
+	assert.True(t, ok)
+	assert.Equal(t, schema.GroupKind{Group: "example.com", Kind: "Foo"}, api.GroupKind)
+	assert.Equal(t, schema.GroupVersionResource{Group: "example.com", Version: "v1beta1", Resource: "foos"}, api.GroupVersionResource)
+	assert.True(t, api.Meta.Namespaced)
+
+	// storage version is not served
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	versions[1].(map[string]interface{})["served"] = false
+	assert.Nil(t, unstructured.SetNestedSlice(crd.Object, versions, "spec", "versions"))
+	api, ok = crdAPIResourceInfo(crd)
+	assert.True(t, ok)
+	assert.Equal(t, "v1alpha1", api.GroupVersionResource.Version)
+
+	// single version CRD
+	unstructured.RemoveNestedField(crd.Object, "spec", "versions")
+	assert.Nil(t, unstructured.SetNestedField(crd.Object, "v1", "spec", "version"))
+	assert.Nil(t, unstructured.SetNestedField(crd.Object, "Cluster", "spec", "scope"))
+	api, ok = crdAPIResourceInfo(crd)
+	assert.True(t, ok)
+	assert.Equal(t, "v1", api.GroupVersionResource.Version)
+	assert.False(t, api.Meta.Namespaced)
+
+	unstructured.RemoveNestedField(crd.Object, "spec", "names", "plural")
+	_, ok = crdAPIResourceInfo(crd)
+	assert.False(t, ok)
+}
+
+func TestStartCRDWatch(t *testing.T) {
+	cluster := newCluster()
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	crd := strToUnstructured(`
+  apiVersion: apiextensions.k8s.io/v1beta1
+  kind: CustomResourceDefinition
+  metadata:
+    name: foos.example.com
+  spec:
+    group: example.com
+    scope: Namespaced
+    version: v1
+    names:
+      kind: Foo
+      plural: foos`)
+
+	// the kind is not discoverable but the watch is started using the CRD spec
+	cluster.lock.Lock()
+	started, err := cluster.startCRDWatch(crd)
+	_, watched := cluster.apisMeta[schema.GroupKind{Group: "example.com", Kind: "Foo"}]
+	cluster.lock.Unlock()
+	assert.Nil(t, err)
+	assert.True(t, started)
+	assert.True(t, watched)
+
+	// incomplete CRD requires full discovery
+	unstructured.RemoveNestedField(crd.Object, "spec", "version")
+	cluster.lock.Lock()
+	started, err = cluster.startCRDWatch(crd)
+	cluster.lock.Unlock()
+	assert.Nil(t, err)
+	assert.False(t, started)
+}
+
+// delayedAPIResourcesKubectl makes the given API resources discoverable only after the specified number of calls
+type delayedAPIResourcesKubectl struct {
+	*kubetest.MockKubectlCmd
+	delayed []kube.APIResourceInfo
+	calls   int
+}
+
+func (k *delayedAPIResourcesKubectl) GetAPIResources(config *rest.Config, resourceFilter kube.ResourceFilter) ([]kube.APIResourceInfo, error) {
+	k.calls--
+	if k.calls < 0 {
+		return append(k.APIResources, k.delayed...), nil
+	}
+	return k.APIResources, nil
+}
+
+// unreachableKubectl fails every server version request
+type unreachableKubectl struct {
+	*kubetest.MockKubectlCmd
+	timeout time.Duration
+}
+
+func (k *unreachableKubectl) GetServerVersion(config *rest.Config) (string, error) {
+	k.timeout = config.Timeout
+	return "", fmt.Errorf("dial tcp: connection refused")
+}
+
+// cannedDiscoverer returns the given server version and API resources
+type cannedDiscoverer struct {
+	version      string
+	apiResources []kube.APIResourceInfo
+}
+
+func (d *cannedDiscoverer) GetServerVersion(config *rest.Config) (string, error) {
+	return d.version, nil
+}
+
+func (d *cannedDiscoverer) GetAPIResources(config *rest.Config, resourceFilter kube.ResourceFilter) ([]kube.APIResourceInfo, error) {
+	return d.apiResources, nil
+}
+
+func TestDiscoverer(t *testing.T) {
+	cluster := newClusterExt(&kubetest.MockKubectlCmd{DynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme(), testPod)})
+	cluster.discoverer = &cannedDiscoverer{version: "1.16", apiResources: []kube.APIResourceInfo{{
+		GroupKind:            schema.GroupKind{Group: "", Kind: "Pod"},
+		GroupVersionResource: schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"},
+		Meta:                 metav1.APIResource{Namespaced: true},
+	}}}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	assert.Equal(t, "1.16", cluster.serverVersion)
+	cluster.lock.RLock()
+	_, watched := cluster.apisMeta[schema.GroupKind{Kind: "Pod"}]
+	_, cached := cluster.nodes[kube.GetResourceKey(testPod)]
+	cluster.lock.RUnlock()
+	assert.True(t, watched)
+	assert.True(t, cached)
+}
+
+func TestSyncFailsFastIfClusterUnreachable(t *testing.T) {
+	kubectl := &unreachableKubectl{MockKubectlCmd: &kubetest.MockKubectlCmd{}}
+	cluster := newClusterExt(kubectl)
+	err := cluster.ensureSynced()
+	assert.True(t, goerrors.Is(err, ErrClusterUnreachable))
+	assert.Contains(t, err.Error(), "connection refused")
+	assert.Equal(t, clusterConnectivityTimeout, kubectl.timeout)
+}
+
+// forbiddenVersionKubectl rejects retrieval of the server version
+type forbiddenVersionKubectl struct {
+	*kubetest.MockKubectlCmd
+}
+
+func (k *forbiddenVersionKubectl) GetServerVersion(config *rest.Config) (string, error) {
+	return "", apierr.NewForbidden(schema.GroupResource{}, "version", fmt.Errorf("access denied"))
+}
+
+func TestSyncSucceedsIfServerVersionFails(t *testing.T) {
+	mock := newCluster(testPod, testRS, testDeploy).kubectl.(*kubetest.MockKubectlCmd)
+	cluster := newClusterExt(&forbiddenVersionKubectl{MockKubectlCmd: mock})
+	cluster.serverVersion = "1.15"
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	assert.Equal(t, "1.15", cluster.serverVersion)
+	assert.Len(t, cluster.nodes, 3)
+	syncErrors := cluster.getSyncErrors()
+	assert.Len(t, syncErrors, 1)
+	assert.True(t, apierr.IsForbidden(syncErrors[ServerVersionGroupKind]))
+}
+
+func TestRetryStartMissingWatchesWaitsForExpectedKind(t *testing.T) {
+	backoff := startMissingWatchesBackoff
+	startMissingWatchesBackoff = wait.Backoff{Steps: 5, Duration: time.Millisecond, Factor: 1}
+	defer func() {
+		startMissingWatchesBackoff = backoff
+	}()
+
+	fooGK := schema.GroupKind{Group: "example.com", Kind: "Foo"}
+	kubectl := &delayedAPIResourcesKubectl{MockKubectlCmd: &kubetest.MockKubectlCmd{
+		DynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme()),
+	}, delayed: []kube.APIResourceInfo{{
+		GroupKind:            fooGK,
+		GroupVersionResource: schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "foos"},
+		Meta:                 metav1.APIResource{Namespaced: true},
+	}}, calls: 100}
+	cluster := newClusterExt(kubectl)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	cluster.lock.Lock()
+	_, ok := cluster.apisMeta[fooGK]
+	cluster.lock.Unlock()
+	assert.False(t, ok)
+
+	kubectl.calls = 2
+	cluster.retryStartMissingWatches(context.Background(), fooGK)
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+	_, ok = cluster.apisMeta[fooGK]
+	assert.True(t, ok)
+	assert.True(t, kubectl.calls < 0)
+}
+
+func TestEventProcessingLag(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(0), cluster.getEventProcessingLag())
+
+	received := make(chan bool)
+	cluster.onEventReceived = func(event watch.EventType, un *unstructured.Unstructured) {
+		received <- true
+	}
+	processed := make(chan bool)
+	start := time.Now()
+	// the time spent waiting for the cluster lock is included into the lag
+	cluster.lock.Lock()
+	go func() {
+		cluster.processEvent(watch.Modified, testPod)
+		processed <- true
+	}()
+	<-received
+	receivedBy := time.Now()
+	unlockedAt := time.Now()
+	cluster.lock.Unlock()
+	<-processed
+	processedBy := time.Now()
+
+	lag := cluster.getEventProcessingLag()
+	assert.True(t, lag >= unlockedAt.Sub(receivedBy))
+	assert.True(t, lag <= processedBy.Sub(start))
+}
+
+func TestSyncErrorsPerKind(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	client.PrependReactor("list", "replicasets", func(action testcore.Action) (bool, runtime.Object, error) {
+		return true, nil, apierr.NewServiceUnavailable("unavailable")
+	})
+
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	syncErrors := cluster.getSyncErrors()
+	assert.Len(t, syncErrors, 1)
+	assert.True(t, apierr.IsServiceUnavailable(syncErrors[schema.GroupKind{Group: "apps", Kind: "ReplicaSet"}]))
+
+	cluster.lock.RLock()
+	_, ok := cluster.nodes[kube.GetResourceKey(testDeploy)]
+	cluster.lock.RUnlock()
+	assert.True(t, ok)
+}
+
+func TestSyncFailsIfNoKindSynced(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	client.PrependReactor("list", "*", func(action testcore.Action) (bool, runtime.Object, error) {
+		return true, nil, apierr.NewServiceUnavailable("unavailable")
+	})
+
+	err := cluster.ensureSynced()
+	assert.NotNil(t, err)
+	assert.Len(t, cluster.getSyncErrors(), 3)
+}
+
+// pagedResourceClient serves the given items page by page and expires the continue token on the specified page once
+type pagedResourceClient struct {
+	dynamic.ResourceInterface
+	items      []unstructured.Unstructured
+	expirePage int
+	requests   []metav1.ListOptions
+}
+
+func (c *pagedResourceClient) List(opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	c.requests = append(c.requests, opts)
+	start := 0
+	if opts.Continue != "" {
+		start, _ = strconv.Atoi(opts.Continue)
+		if start/int(opts.Limit) == c.expirePage {
+			c.expirePage = -1
+			return nil, apierr.NewResourceExpired("continue token has expired")
+		}
+	}
+	end := start + int(opts.Limit)
+	list := &unstructured.UnstructuredList{}
+	if end < len(c.items) {
+		list.SetContinue(strconv.Itoa(end))
+	} else {
+		end = len(c.items)
+	}
+	list.Items = c.items[start:end]
+	list.SetResourceVersion(strconv.Itoa(len(c.requests)))
+	return list, nil
+}
+
+func TestListAllPages(t *testing.T) {
+	resClient := &pagedResourceClient{items: []unstructured.Unstructured{*testPod, *testRS, *testDeploy}, expirePage: 1}
+
+	list, err := listAllPages(context.Background(), resClient, metav1.ListOptions{Limit: 2})
+	assert.Nil(t, err)
+	assert.Len(t, list.Items, 3)
+	// second page request fails with expired token, so the list is restarted
+	assert.Equal(t, []metav1.ListOptions{{Limit: 2}, {Limit: 2, Continue: "2"}, {Limit: 2}, {Limit: 2, Continue: "2"}}, resClient.requests)
+	assert.Equal(t, "4", list.GetResourceVersion())
+}
+
+func TestPreferredVersionsOnly(t *testing.T) {
+	cluster := newClusterExt(&kubetest.MockKubectlCmd{
+		DynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme(), testDeploy, testRS),
+		APIResources: []kube.APIResourceInfo{{
+			GroupKind:            schema.GroupKind{Group: "apps", Kind: "Deployment"},
+			GroupVersionResource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+			Meta:                 metav1.APIResource{Namespaced: true},
+			PreferredVersion:     true,
+		}, {
+			GroupKind:            schema.GroupKind{Group: "apps", Kind: "ReplicaSet"},
+			GroupVersionResource: schema.GroupVersionResource{Group: "apps", Version: "v1beta2", Resource: "replicasets"},
+			Meta:                 metav1.APIResource{Namespaced: true},
+		}},
+	})
+	cluster.preferredVersionsOnly = true
+
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	cluster.lock.RLock()
+	defer cluster.lock.RUnlock()
+	_, ok := cluster.nodes[kube.GetResourceKey(testDeploy)]
+	assert.True(t, ok)
+	_, ok = cluster.nodes[kube.GetResourceKey(testRS)]
+	assert.False(t, ok)
+	_, ok = cluster.apisMeta[schema.GroupKind{Group: "apps", Kind: "ReplicaSet"}]
+	assert.False(t, ok)
+}
+
+func TestWatchBytesReceived(t *testing.T) {
+	cluster := newCluster()
+	cluster.trackWatchBytes = true
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	podGK := schema.GroupKind{Group: "", Kind: "Pod"}
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		cluster.lock.RLock()
+		defer cluster.lock.RUnlock()
+		return cluster.apisMeta[podGK].watching, nil
+	})
+	assert.Nil(t, err)
+
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient
+	_, err = client.Resource(schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}).Namespace(testPod.GetNamespace()).Create(testPod.DeepCopy(), metav1.CreateOptions{})
+	assert.Nil(t, err)
+
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return cluster.getWatchBytesReceived()[podGK] > 0, nil
+	})
+	assert.Nil(t, err)
+	data, err := testPod.MarshalJSON()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(data)), cluster.getWatchBytesReceived()[podGK])
+}
+
+func TestRefreshKind(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	// requested re-list is not a watch failure, so the watch is restarted without the retry delay
+	cluster.watchRetryBackoff = &wait.Backoff{Duration: time.Hour, Factor: 1}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	podGK := schema.GroupKind{Group: "", Kind: "Pod"}
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		cluster.lock.RLock()
+		defer cluster.lock.RUnlock()
+		return cluster.apisMeta[podGK].watching, nil
+	})
+	assert.Nil(t, err)
+
+	// simulate drift of the cached resources
+	cluster.lock.Lock()
+	cluster.removeNode(kube.GetResourceKey(testPod))
+	cluster.lock.Unlock()
+
+	err = cluster.refreshKind(podGK)
+	assert.Nil(t, err)
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		cluster.lock.RLock()
+		defer cluster.lock.RUnlock()
+		_, ok := cluster.nodes[kube.GetResourceKey(testPod)]
+		return ok, nil
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, cluster.getWatchStatus())
+
+	assert.Error(t, cluster.refreshKind(schema.GroupKind{Group: "example.com", Kind: "Unknown"}))
+}
+
+func TestRefreshKindNamespaces(t *testing.T) {
+	pod1 := testPod.DeepCopy()
+	pod1.SetNamespace("default1")
+	pod2 := testPod.DeepCopy()
+	pod2.SetNamespace("default2")
+	cluster := newCluster(pod1, pod2)
+	cluster.cluster.Namespaces = []string{"default1", "default2"}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	podGK := schema.GroupKind{Group: "", Kind: "Pod"}
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		cluster.lock.RLock()
+		defer cluster.lock.RUnlock()
+		return cluster.apisMeta[podGK].watching, nil
+	})
+	assert.Nil(t, err)
+
+	// simulate drift of the cached resources of both namespaces which watches have progressed
+	cluster.lock.Lock()
+	info := cluster.apisMeta[podGK]
+	info.setResourceVersion("default1", "100")
+	info.setResourceVersion("default2", "200")
+	cluster.removeNode(kube.GetResourceKey(pod1))
+	cluster.removeNode(kube.GetResourceKey(pod2))
+	cluster.lock.Unlock()
+
+	err = cluster.refreshKind(podGK)
+	assert.Nil(t, err)
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		cluster.lock.RLock()
+		defer cluster.lock.RUnlock()
+		_, ok1 := cluster.nodes[kube.GetResourceKey(pod1)]
+		_, ok2 := cluster.nodes[kube.GetResourceKey(pod2)]
+		return ok1 && ok2, nil
+	})
+	assert.Nil(t, err)
+
+	cluster.lock.RLock()
+	defer cluster.lock.RUnlock()
+	assert.NotEqual(t, "100", info.resourceVersion("default1"))
+	assert.NotEqual(t, "200", info.resourceVersion("default2"))
+}
+
+func TestGetEventStats(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	pod := testPod.DeepCopy()
+	cluster.processEvent(watch.Modified, pod)
+	cluster.processEvent(watch.Modified, pod)
+	cluster.processEvent(watch.Deleted, pod)
+	cluster.processEvent(watch.Added, testRS.DeepCopy())
+
+	stats := cluster.getEventStats(true)
+	podStats := stats[schema.GroupKind{Kind: "Pod"}]
+	assert.Equal(t, int64(0), podStats.Added)
+	assert.Equal(t, int64(2), podStats.Modified)
+	assert.Equal(t, int64(1), podStats.Deleted)
+	assert.False(t, podStats.LastEventTime.IsZero())
+	assert.Equal(t, int64(1), stats[schema.GroupKind{Group: "apps", Kind: "ReplicaSet"}].Added)
+
+	assert.Empty(t, cluster.getEventStats(false))
+}
+
+func TestResourceLabelSelector(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	cluster.resourceLabelSelector = labels.SelectorFromSet(map[string]string{"app.kubernetes.io/instance": "helm-guestbook"})
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	cluster.lock.RLock()
+	_, deployCached := cluster.nodes[kube.GetResourceKey(testDeploy)]
+	_, podCached := cluster.nodes[kube.GetResourceKey(testPod)]
+	cluster.lock.RUnlock()
+	assert.True(t, deployCached)
+	assert.False(t, podCached)
+
+	for _, kind := range cluster.dumpWatchConfig().Kinds {
+		assert.Equal(t, "app.kubernetes.io/instance=helm-guestbook", kind.LabelSelector)
+	}
+}
+
+func TestFieldSelector(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	podGK := schema.GroupKind{Kind: "Pod"}
+	cluster.fieldSelectors = map[schema.GroupKind]string{podGK: "status.phase=Running"}
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	var lock sync.Mutex
+	selectors := map[string]string{}
+	client.PrependReactor("list", "*", func(action testcore.Action) (bool, runtime.Object, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		selectors[action.GetResource().Resource] = action.(testcore.ListAction).GetListRestrictions().Fields.String()
+		return false, nil, nil
+	})
+
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	lock.Lock()
+	assert.Equal(t, "status.phase=Running", selectors["pods"])
+	assert.Equal(t, "", selectors["replicasets"])
+	lock.Unlock()
+
+	for _, kind := range cluster.dumpWatchConfig().Kinds {
+		if kind.GroupKind == podGK {
+			assert.Equal(t, "status.phase=Running", kind.FieldSelector)
+		} else {
+			assert.Equal(t, "", kind.FieldSelector)
+		}
+	}
+}
+
+func TestUnsupportedFieldSelector(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	podGK := schema.GroupKind{Kind: "Pod"}
+	cluster.fieldSelectors = map[schema.GroupKind]string{podGK: "spec.unknown=foo"}
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	client.PrependReactor("list", "pods", func(action testcore.Action) (bool, runtime.Object, error) {
+		if !action.(testcore.ListAction).GetListRestrictions().Fields.Empty() {
+			return true, nil, apierr.NewBadRequest("field label not supported: spec.unknown")
+		}
+		return false, nil, nil
+	})
+
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	assert.Empty(t, cluster.getSyncErrors())
+
+	cluster.lock.RLock()
+	_, podCached := cluster.nodes[kube.GetResourceKey(testPod)]
+	cluster.lock.RUnlock()
+	assert.True(t, podCached)
+	assert.Equal(t, "", cluster.fieldSelector(podGK))
+}
+
+func TestWatchBookmarks(t *testing.T) {
+	cluster := newCluster(testPod)
+	cluster.watchBookmarks = true
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	podWatch := watch.NewFake()
+	client.PrependWatchReactor("pods", func(action testcore.Action) (bool, watch.Interface, error) {
+		return true, podWatch, nil
+	})
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	podGK := schema.GroupKind{Kind: "Pod"}
+	bookmark := &unstructured.Unstructured{}
+	bookmark.SetAPIVersion("v1")
+	bookmark.SetKind("Pod")
+	bookmark.SetResourceVersion("999")
+	podWatch.Action(watch.Bookmark, bookmark)
+
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		cluster.lock.RLock()
+		defer cluster.lock.RUnlock()
+		return cluster.apisMeta[podGK].resourceVersion("") == "999", nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, cluster.getClusterInfo().ResourcesCount)
+}
+
+func TestWatchErrorEvent(t *testing.T) {
+	t.Run("Status", func(t *testing.T) {
+		testWatchErrorEvent(t, &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusGone,
+			Reason:  metav1.StatusReasonExpired,
+			Message: "too old resource version",
+		})
+	})
+	// the dynamic client delivers error statuses as unstructured objects
+	t.Run("Unstructured", func(t *testing.T) {
+		testWatchErrorEvent(t, &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Status",
+			"status":     metav1.StatusFailure,
+			"code":       int64(http.StatusGone),
+			"reason":     string(metav1.StatusReasonGone),
+			"message":    "too old resource version",
+		}})
+	})
+}
+
+func testWatchErrorEvent(t *testing.T, status runtime.Object) {
+	cluster := newCluster(testPod)
+	var panics int32
+	cluster.onPanic = func(gk schema.GroupKind, recovered interface{}, stack []byte) {
+		atomic.AddInt32(&panics, 1)
+	}
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	watches := make(chan *watch.FakeWatcher, 10)
+	client.PrependWatchReactor("pods", func(action testcore.Action) (bool, watch.Interface, error) {
+		podWatch := watch.NewFake()
+		select {
+		case watches <- podWatch:
+		default:
+		}
+		return true, podWatch, nil
+	})
+	var relists int32
+	client.PrependReactor("list", "pods", func(action testcore.Action) (bool, runtime.Object, error) {
+		if atomic.LoadInt32(&cluster.syncing) == 0 {
+			atomic.AddInt32(&relists, 1)
+		}
+		return false, nil, nil
+	})
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	podWatch := <-watches
+	podWatch.Error(status)
+
+	// the resource version is reset, so the kind is re-listed
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return atomic.LoadInt32(&relists) > 0, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&panics))
+	assert.Contains(t, cluster.getWatchStatus()[0].LastError, "too old resource version")
+}
+
+func TestNamespaceWatchConcurrency(t *testing.T) {
+	cluster := newCluster()
+	cluster.namespaceWatchConcurrency = 2
+	namespaces := make([]string, 10)
+	for i := range namespaces {
+		namespaces[i] = "ns" + strconv.Itoa(i)
+	}
+	cluster.cluster.Namespaces = namespaces
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	var lock sync.Mutex
+	inFlight, maxInFlight, started := 0, 0, 0
+	release := make(chan bool)
+	client.PrependWatchReactor("*", func(action testcore.Action) (bool, watch.Interface, error) {
+		lock.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		lock.Unlock()
+		<-release
+		lock.Lock()
+		inFlight--
+		started++
+		lock.Unlock()
+		return false, nil, nil
+	})
+
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	// watches block until released, so the rest of the watches wait for the free slots
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		return inFlight == 2, nil
+	})
+	assert.Nil(t, err)
+	close(release)
+
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		// 3 kinds are watched in every namespace
+		return started == 3*len(namespaces), nil
+	})
+	assert.Nil(t, err)
+	lock.Lock()
+	assert.True(t, maxInFlight <= 2)
+	lock.Unlock()
+}
+
+// countingRateLimiter counts watch requests which waited for the rate limiter
+type countingRateLimiter struct {
+	flowcontrol.RateLimiter
+	lock  sync.Mutex
+	waits int
+}
+
+func (l *countingRateLimiter) Wait(ctx context.Context) error {
+	l.lock.Lock()
+	l.waits++
+	l.lock.Unlock()
+	return l.RateLimiter.Wait(ctx)
+}
+
+func TestWatchEstablishRateLimiter(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	limiter := &countingRateLimiter{RateLimiter: flowcontrol.NewTokenBucketRateLimiter(100, 1)}
+	cluster.watchEstablishRateLimiter = limiter
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		limiter.lock.Lock()
+		defer limiter.lock.Unlock()
+		return limiter.waits == 3, nil
+	})
+	assert.Nil(t, err)
+}
+
+func TestMaxConcurrentListsPerSync(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	cluster.maxConcurrentListsPerSync = 2
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	var lock sync.Mutex
+	inFlight, maxInFlight, lists := 0, 0, 0
+	release := make(chan bool)
+	client.PrependReactor("list", "*", func(action testcore.Action) (bool, runtime.Object, error) {
+		if atomic.LoadInt32(&cluster.syncing) == 0 {
+			// lists performed by watches are not limited
+			return false, nil, nil
+		}
+		lock.Lock()
+		inFlight++
+		lists++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		lock.Unlock()
+		<-release
+		lock.Lock()
+		inFlight--
+		lock.Unlock()
+		return false, nil, nil
+	})
+
+	synced := make(chan error)
+	go func() {
+		synced <- cluster.ensureSynced()
+	}()
+	// lists block until released, so the rest of the lists wait for the free slots
+	err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		return inFlight == 2, nil
+	})
+	assert.Nil(t, err)
+	close(release)
+	err = <-synced
+	assert.Nil(t, err)
+	assert.Len(t, cluster.nodes, 3)
+	lock.Lock()
+	defer lock.Unlock()
+	assert.True(t, lists >= 3)
+	assert.True(t, maxInFlight <= 2)
+}
+
+func TestPriorityKinds(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	cluster.maxConcurrentListsPerSync = 1
+	cluster.priorityKinds = []schema.GroupKind{{Group: "apps", Kind: "Deployment"}, {Group: "apps", Kind: "ReplicaSet"}}
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	var lock sync.Mutex
+	var listed []string
+	seen := make(map[string]bool)
+	client.PrependReactor("list", "*", func(action testcore.Action) (bool, runtime.Object, error) {
+		if atomic.LoadInt32(&cluster.syncing) == 1 {
+			lock.Lock()
+			// watches of the published priority kinds list them again while the rest of the kinds are listed
+			resource := action.GetResource().Resource
+			if !seen[resource] {
+				seen[resource] = true
+				listed = append(listed, resource)
+			}
+			lock.Unlock()
+		}
+		return false, nil, nil
+	})
+
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Len(t, listed, 3)
+	assert.ElementsMatch(t, []string{"deployments", "replicasets"}, listed[:2])
+	assert.Equal(t, "pods", listed[2])
+}
+
+func TestPriorityKindsReadableBeforeSyncCompletes(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	cluster.priorityKinds = []schema.GroupKind{{Group: "apps", Kind: "Deployment"}}
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	listingPods := make(chan struct{})
+	releasePods := make(chan struct{})
+	var blockOnce sync.Once
+	client.PrependReactor("list", "pods", func(action testcore.Action) (bool, runtime.Object, error) {
+		blockOnce.Do(func() {
+			close(listingPods)
+			<-releasePods
+		})
+		return false, nil, nil
+	})
+	cache := &liveStateCache{
+		lock:     &sync.Mutex{},
+		clusters: map[string]*clusterInfo{cluster.cluster.Server: cluster},
+	}
+
+	syncErr := make(chan error, 1)
+	go func() {
+		syncErr <- cluster.ensureSynced()
+	}()
+	select {
+	case <-listingPods:
+	case <-time.After(5 * time.Second):
+		t.Fatal("pods are not listed")
+	}
+
+	targetDeploy := strToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: helm-guestbook
+  labels:
+    app: helm-guestbook`)
+	app := &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "helm-guestbook"},
+		Spec:       appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Server: cluster.cluster.Server, Namespace: "default"}},
+	}
+	managedObjs, err := cache.GetManagedLiveObjs(app, []*unstructured.Unstructured{targetDeploy})
+	assert.Nil(t, err)
+	assert.Equal(t, map[kube.ResourceKey]*unstructured.Unstructured{
+		kube.NewResourceKey("apps", "Deployment", "default", "helm-guestbook"): testDeploy,
+	}, managedObjs)
+
+	var hierarchy []string
+	err = cache.IterateHierarchy(cluster.cluster.Server, kube.GetResourceKey(testDeploy), func(child appv1.ResourceNode, _ string) {
+		hierarchy = append(hierarchy, child.Kind)
+	})
+	assert.Nil(t, err)
+	assert.Contains(t, hierarchy, "Deployment")
+	assert.Equal(t, Syncing, cluster.getSyncStatus())
+
+	close(releasePods)
+	select {
+	case err = <-syncErr:
+		assert.Nil(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("sync is not completed")
+	}
+	assert.Equal(t, Synced, cluster.getSyncStatus())
+	cluster.lock.RLock()
+	_, ok := cluster.nodes[kube.GetResourceKey(testPod)]
+	cluster.lock.RUnlock()
+	assert.True(t, ok)
+}
+
+// recordingResourceClient records options of list requests and optionally rejects lists with the resource version
+type recordingResourceClient struct {
+	dynamic.ResourceInterface
+	listOpts        []metav1.ListOptions
+	rejectVersioned bool
+}
+
+func (c *recordingResourceClient) List(opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	c.listOpts = append(c.listOpts, opts)
+	if c.rejectVersioned && opts.ResourceVersion != "" {
+		return nil, apierr.NewBadRequest("resourceVersion is not supported")
+	}
+	return c.ResourceInterface.List(opts)
+}
+
+func TestMinResourceVersion(t *testing.T) {
+	cluster := newCluster(testPod)
+	cluster.minResourceVersion = "100"
+	cluster.listPageSize = 10
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient
+	resClient := &recordingResourceClient{ResourceInterface: client.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"})}
+
+	list, err := cluster.listKind(context.Background(), schema.GroupKind{Kind: "Pod"}, resClient)
+	assert.Nil(t, err)
+	assert.Len(t, list.Items, 1)
+	assert.Len(t, resClient.listOpts, 1)
+	assert.Equal(t, "100", resClient.listOpts[0].ResourceVersion)
+	assert.Equal(t, int64(0), resClient.listOpts[0].Limit)
+
+	// falls back to the default consistency if the API server rejects the resource version
+	resClient = &recordingResourceClient{ResourceInterface: resClient.ResourceInterface, rejectVersioned: true}
+	list, err = cluster.listKind(context.Background(), schema.GroupKind{Kind: "Pod"}, resClient)
+	assert.Nil(t, err)
+	assert.Len(t, list.Items, 1)
+	assert.Len(t, resClient.listOpts, 2)
+	assert.Equal(t, "", resClient.listOpts[1].ResourceVersion)
+	assert.Equal(t, int64(10), resClient.listOpts[1].Limit)
+}
+
+func TestStop(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	cluster.stop()
+
+	err = cluster.ensureSynced()
+	assert.Equal(t, errClusterCacheStopped, err)
+	cluster.processEvent(watch.Modified, testPod)
+	cluster.iterateHierarchy(kube.GetResourceKey(testRS), func(child appv1.ResourceNode, app string) {
+		assert.Fail(t, "stopped cache should be empty")
+	})
+	assert.Empty(t, cluster.getNamespaceTopLevelResources("default"))
+	assert.Equal(t, 0, cluster.getClusterInfo().ResourcesCount)
+}
+
+func TestWatchStopped(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	var lock sync.Mutex
+	stopped := make(map[schema.GroupKind]string)
+	cluster.onWatchStopped = func(gk schema.GroupKind, reason string) {
+		lock.Lock()
+		defer lock.Unlock()
+		stopped[gk] = reason
+	}
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	client.PrependWatchReactor("pods", func(action testcore.Action) (bool, watch.Interface, error) {
+		return true, nil, apierr.NewNotFound(schema.GroupResource{Resource: "pods"}, "")
+	})
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	podGK := schema.GroupKind{Kind: "Pod"}
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		_, ok := stopped[podGK]
+		return ok, nil
+	})
+	assert.Nil(t, err)
+
+	rsGK := schema.GroupKind{Group: "apps", Kind: "ReplicaSet"}
+	err = cluster.evictKind(rsGK)
+	assert.Nil(t, err)
+	cluster.stop()
+
+	deployGK := schema.GroupKind{Group: "apps", Kind: "Deployment"}
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, map[schema.GroupKind]string{
+		podGK:    WatchStopReasonNotFound,
+		rsGK:     WatchStopReasonEvicted,
+		deployGK: WatchStopReasonCacheStopped,
+	}, stopped)
+}
+
+func TestWatchPanicHandler(t *testing.T) {
+	cluster := newCluster()
+	panicked := make(chan schema.GroupKind, 1)
+	cluster.onPanic = func(gk schema.GroupKind, recovered interface{}, stack []byte) {
+		assert.Equal(t, "test panic", recovered)
+		assert.NotEmpty(t, stack)
+		panicked <- gk
+	}
+	cluster.onEventReceived = func(event watch.EventType, un *unstructured.Unstructured) {
+		panic("test panic")
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	podGK := schema.GroupKind{Group: "", Kind: "Pod"}
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		cluster.lock.RLock()
+		defer cluster.lock.RUnlock()
+		return cluster.apisMeta[podGK].watching, nil
+	})
+	assert.Nil(t, err)
+
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient
+	_, err = client.Resource(schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}).Namespace(testPod.GetNamespace()).Create(testPod.DeepCopy(), metav1.CreateOptions{})
+	assert.Nil(t, err)
+
+	select {
+	case gk := <-panicked:
+		assert.Equal(t, podGK, gk)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "panic handler has not been called")
+	}
+}
+
+func TestGetSortedResources(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	resources := cluster.getSortedResources("default", func(a, b *appv1.ResourceNode) bool {
+		return a.Kind < b.Kind
+	})
+	kinds := make([]string, 0)
+	for _, res := range resources {
+		kinds = append(kinds, res.Kind)
+	}
+	assert.Equal(t, []string{"Deployment", "Pod", "ReplicaSet"}, kinds)
+	assert.Empty(t, cluster.getSortedResources("missing", func(a, b *appv1.ResourceNode) bool {
+		return a.Name < b.Name
+	}))
+}
+
+type fakeWatchMetricsRecorder struct {
+	lock          sync.Mutex
+	reconnects    map[schema.GroupKind]int
+	listDurations map[schema.GroupKind]int
+}
+
+func (r *fakeWatchMetricsRecorder) OnWatchReconnect(gk schema.GroupKind) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.reconnects[gk]++
+}
+
+func (r *fakeWatchMetricsRecorder) OnListDuration(gk schema.GroupKind, duration time.Duration) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.listDurations[gk]++
+}
+
+func TestWatchMetricsRecorder(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	recorder := &fakeWatchMetricsRecorder{reconnects: map[schema.GroupKind]int{}, listDurations: map[schema.GroupKind]int{}}
+	cluster.metricsRecorder = recorder
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	// watches might re-list resources in background, so at least sync lists should be recorded
+	assert.True(t, recorder.listDurations[schema.GroupKind{Group: "apps", Kind: "Deployment"}] >= 1)
+	assert.True(t, recorder.listDurations[schema.GroupKind{Group: "", Kind: "Pod"}] >= 1)
+	assert.Empty(t, recorder.reconnects)
+}
+
+func TestWatchRetryDelay(t *testing.T) {
+	backoff := wait.Backoff{Duration: time.Second, Factor: 2, Cap: 10 * time.Second}
+	assert.Equal(t, time.Second, watchRetryDelay(backoff, 1))
+	assert.Equal(t, 2*time.Second, watchRetryDelay(backoff, 2))
+	assert.Equal(t, 8*time.Second, watchRetryDelay(backoff, 4))
+	assert.Equal(t, 10*time.Second, watchRetryDelay(backoff, 5))
+	assert.Equal(t, 10*time.Second, watchRetryDelay(backoff, 100))
+
+	backoff.Jitter = 0.5
+	for i := 1; i < 10; i++ {
+		delay := watchRetryDelay(backoff, i)
+		assert.True(t, delay >= watchRetryDelay(wait.Backoff{Duration: time.Second, Factor: 2, Cap: 10 * time.Second}, i))
+		assert.True(t, delay <= 10*time.Second)
+	}
+}
+
+func TestWatchRetryDelayZeroFactor(t *testing.T) {
+	// omitted factor means constant delay rather than no delay
+	backoff := wait.Backoff{Duration: time.Second}
+	for i := 1; i < 5; i++ {
+		assert.Equal(t, time.Second, watchRetryDelay(backoff, i))
+	}
+	assert.Equal(t, watchResourcesRetryTimeout, watchRetryDelay(wait.Backoff{}, 3))
+	// shrinking delay is clamped to the minimum
+	assert.Equal(t, minWatchRetryDelay, watchRetryDelay(wait.Backoff{Duration: time.Second, Factor: 0.1}, 10))
+}
+
+func TestNormalizeLiveObj(t *testing.T) {
+	normalized := normalizeLiveObj(testService, nil)
+
+	_, hasStatus := normalized.Object["status"]
+	assert.False(t, hasStatus)
+	assert.Empty(t, normalized.GetResourceVersion())
+	assert.Empty(t, normalized.GetUID())
+	assert.Equal(t, testService.GetName(), normalized.GetName())
+	assert.Equal(t, testService.Object["spec"], normalized.Object["spec"])
+
+	// cached object should not be modified
+	_, hasStatus = testService.Object["status"]
+	assert.True(t, hasStatus)
+	assert.Equal(t, "123", testService.GetResourceVersion())
+}
+
+func TestGetResource(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	res, ok := cluster.getResource(kube.GetResourceKey(testRS))
+	assert.True(t, ok)
+	assert.Equal(t, testRS.GetName(), res.Name)
+	assert.Equal(t, "ReplicaSet", res.Kind)
+
+	_, ok = cluster.getResource(kube.NewResourceKey("apps", "ReplicaSet", "default", "missing"))
+	assert.False(t, ok)
+}
+
+func TestResourceInfoChanged(t *testing.T) {
+	cluster := newCluster(testPod)
+	var changes []appv1.ResourceNode
+	cluster.onResourceInfoChanged = func(oldRes, newRes appv1.ResourceNode) {
+		changes = append(changes, newRes)
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	// resource version change only
+	pod := testPod.DeepCopy()
+	pod.SetResourceVersion("124")
+	cluster.processEvent(watch.Modified, pod)
+	assert.Empty(t, changes)
+
+	pod = pod.DeepCopy()
+	pod.SetResourceVersion("125")
+	err = unstructured.SetNestedSlice(pod.Object, []interface{}{map[string]interface{}{"name": "guestbook", "image": "guestbook:v2"}}, "spec", "containers")
+	assert.Nil(t, err)
+	cluster.processEvent(watch.Modified, pod)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, []string{"guestbook:v2"}, changes[0].Images)
+}
+
+func TestResourceInfoChangedCustomEquality(t *testing.T) {
+	cluster := newCluster(testPod)
+	changed := 0
+	cluster.onResourceInfoChanged = func(oldRes, newRes appv1.ResourceNode) {
+		changed++
+	}
+	cluster.resourceInfoEqual = func(oldRes, newRes *appv1.ResourceNode) bool {
+		return oldRes.ResourceVersion == newRes.ResourceVersion
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	pod := testPod.DeepCopy()
+	pod.SetResourceVersion("124")
+	cluster.processEvent(watch.Modified, pod)
+	assert.Equal(t, 1, changed)
+}
+
+func TestSkipNoOpUpdates(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	cluster.skipNoOpUpdates = true
+	var updated []string
+	cluster.onObjectUpdated = func(managedByApp map[string]bool, ref corev1.ObjectReference, event watch.EventType) {
+		updated = append(updated, ref.Name)
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	for _, obj := range []*unstructured.Unstructured{testPod, testDeploy} {
+		modified := obj.DeepCopy()
+		modified.SetResourceVersion("124")
+		modified.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl"}})
+		cluster.processEvent(watch.Modified, modified)
+		assert.Empty(t, updated)
+		assert.Equal(t, "124", cluster.nodes[kube.GetResourceKey(obj)].resourceVersion)
+	}
+
+	pod := testPod.DeepCopy()
+	pod.SetResourceVersion("125")
+	pod.SetLabels(map[string]string{"foo": "bar"})
+	cluster.processEvent(watch.Modified, pod)
+	assert.Equal(t, []string{testPod.GetName()}, updated)
+}
+
+// manualTicker replaces the event coalesce window ticker, so the test decides when pending notifications are flushed
+type manualTicker struct {
+	ticks   chan time.Time
+	started int32
+	stopped int32
+}
+
+func (t *manualTicker) newTicker(window time.Duration) (<-chan time.Time, func()) {
+	atomic.AddInt32(&t.started, 1)
+	return t.ticks, func() {
+		atomic.AddInt32(&t.stopped, 1)
+	}
+}
+
+// tick ends the window and waits until the pending notifications are flushed
+func (t *manualTicker) tick() {
+	t.ticks <- time.Now()
+	// the loop receives the next tick only after the previous flush completes
+	t.ticks <- time.Now()
+}
+
+func TestCoalesceEvents(t *testing.T) {
+	for _, tc := range []struct {
+		pending  watch.EventType
+		event    watch.EventType
+		expected watch.EventType
+		reported bool
+	}{
+		{watch.Added, watch.Added, watch.Added, true},
+		{watch.Added, watch.Modified, watch.Added, true},
+		{watch.Added, watch.Deleted, "", false},
+		{watch.Modified, watch.Added, watch.Modified, true},
+		{watch.Modified, watch.Modified, watch.Modified, true},
+		{watch.Modified, watch.Deleted, watch.Deleted, true},
+		{watch.Deleted, watch.Added, watch.Modified, true},
+		{watch.Deleted, watch.Modified, watch.Modified, true},
+		{watch.Deleted, watch.Deleted, watch.Deleted, true},
+	} {
+		event, reported := coalesceEvents(tc.pending, tc.event)
+		assert.Equal(t, tc.reported, reported, "%s + %s", tc.pending, tc.event)
+		if tc.reported {
+			assert.Equal(t, tc.expected, event, "%s + %s", tc.pending, tc.event)
+		}
+	}
+}
+
+func TestEventCoalesceWindow(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	cluster.eventCoalesceWindow = time.Minute
+	ticker := &manualTicker{ticks: make(chan time.Time)}
+	cluster.coalesceTicker = ticker.newTicker
+	var lock sync.Mutex
+	var updated []watch.EventType
+	cluster.onObjectUpdated = func(managedByApp map[string]bool, ref corev1.ObjectReference, event watch.EventType) {
+		lock.Lock()
+		defer lock.Unlock()
+		if ref.Name == testPod.GetName() || ref.Name == "new-pod" {
+			updated = append(updated, event)
+		}
+	}
+	getUpdated := func() []watch.EventType {
+		lock.Lock()
+		defer lock.Unlock()
+		res := updated
+		updated = nil
+		return res
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	defer cluster.stop()
+
+	for i := 0; i < 10; i++ {
+		pod := testPod.DeepCopy()
+		pod.SetResourceVersion(strconv.Itoa(200 + i))
+		cluster.processEvent(watch.Modified, pod)
+	}
+	// the cache is updated immediately
+	cluster.lock.RLock()
+	assert.Equal(t, "209", cluster.nodes[kube.GetResourceKey(testPod)].resourceVersion)
+	cluster.lock.RUnlock()
+	assert.Empty(t, getUpdated())
+
+	ticker.tick()
+	assert.Equal(t, []watch.EventType{watch.Modified}, getUpdated())
+
+	// the resource added within the window is reported as added
+	newPod := testPod.DeepCopy()
+	newPod.SetName("new-pod")
+	newPod.SetUID("10")
+	cluster.processEvent(watch.Added, newPod)
+	cluster.processEvent(watch.Modified, newPod)
+	ticker.tick()
+	assert.Equal(t, []watch.EventType{watch.Added}, getUpdated())
+
+	// the resource deleted and re-created within the window is reported as modified
+	cluster.processEvent(watch.Deleted, newPod)
+	cluster.processEvent(watch.Added, newPod)
+	ticker.tick()
+	assert.Equal(t, []watch.EventType{watch.Modified}, getUpdated())
+
+	cluster.processEvent(watch.Modified, newPod)
+	cluster.processEvent(watch.Deleted, newPod)
+	ticker.tick()
+	assert.Equal(t, []watch.EventType{watch.Deleted}, getUpdated())
+
+	// the resource added and deleted within the window is not reported
+	cluster.processEvent(watch.Added, newPod)
+	cluster.processEvent(watch.Deleted, newPod)
+	ticker.tick()
+	assert.Empty(t, getUpdated())
+
+	// invalidation flushes pending notifications and stops the loop
+	cluster.processEvent(watch.Modified, testPod)
+	cluster.invalidate()
+	assert.Equal(t, []watch.EventType{watch.Modified}, getUpdated())
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return atomic.LoadInt32(&ticker.stopped) == 1, nil
+	})
+	assert.Nil(t, err)
+
+	// the loop is restarted by the next notification
+	err = cluster.ensureSynced()
+	assert.Nil(t, err)
+	cluster.processEvent(watch.Modified, testPod)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&ticker.started))
+	ticker.tick()
+	assert.Equal(t, []watch.EventType{watch.Modified}, getUpdated())
+}
+
+func TestParentResolved(t *testing.T) {
+	cluster := newCluster(testPod)
+	var resolved [][2]string
+	cluster.onParentResolved = func(parent corev1.ObjectReference, child corev1.ObjectReference) {
+		resolved = append(resolved, [2]string{parent.Name, child.Name})
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	cluster.processEvent(watch.Added, testRS)
+	assert.Equal(t, [][2]string{{testRS.GetName(), testPod.GetName()}}, resolved)
+
+	// handler is not notified about children of the updated parent
+	rs := testRS.DeepCopy()
+	rs.SetResourceVersion("124")
+	cluster.processEvent(watch.Modified, rs)
+	assert.Len(t, resolved, 1)
+}
+
+func TestUnresolvedChildrenPruned(t *testing.T) {
+	pod := testPod.DeepCopy()
+	pod.SetOwnerReferences(nil)
+	pod.SetLabels(map[string]string{"parent-deployment": "missing"})
+	cluster := newCluster(pod)
+	cluster.ownerResolver = func(un *unstructured.Unstructured) []metav1.OwnerReference {
+		if name, ok := un.GetLabels()["parent-deployment"]; ok {
+			return []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: name}}
+		}
+		return nil
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	assert.Contains(t, cluster.unresolvedChildren, kube.NewResourceKey("apps", "Deployment", "default", "missing"))
+
+	// updated child no longer waits for the owner it stopped referencing
+	pod = pod.DeepCopy()
+	pod.SetResourceVersion("124")
+	pod.SetLabels(map[string]string{"parent-deployment": "other"})
+	cluster.processEvent(watch.Modified, pod)
+	assert.NotContains(t, cluster.unresolvedChildren, kube.NewResourceKey("apps", "Deployment", "default", "missing"))
+	assert.Contains(t, cluster.unresolvedChildren, kube.NewResourceKey("apps", "Deployment", "default", "other"))
+
+	// removed child doesn't wait for the owner
+	cluster.processEvent(watch.Deleted, pod)
+	assert.Empty(t, cluster.unresolvedChildren)
+}
+
+func TestGetInventoryMatrix(t *testing.T) {
+	otherPod := testPod.DeepCopy()
+	otherPod.SetName("other-pod")
+	otherPod.SetNamespace("other")
+	otherPod.SetUID("10")
+	cluster := newCluster(testPod, testRS, testDeploy, otherPod)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]map[schema.GroupKind]int{
+		"default": {
+			{Kind: "Pod"}:                       1,
+			{Group: "apps", Kind: "ReplicaSet"}: 1,
+			{Group: "apps", Kind: "Deployment"}: 1,
+		},
+		"other": {{Kind: "Pod"}: 1},
+	}, cluster.getInventoryMatrix())
+}
+
+func TestGetEmptyWatchedKinds(t *testing.T) {
+	cluster := newCluster(testPod)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	assert.Equal(t, []schema.GroupKind{{Group: "apps", Kind: "Deployment"}, {Group: "apps", Kind: "ReplicaSet"}}, cluster.getEmptyWatchedKinds())
+}
+
+func TestGetResourceCountByGroupKind(t *testing.T) {
+	extensionsRS := testRS.DeepCopy()
+	extensionsRS.SetName("extensions-rs")
+	cluster := newCluster(testPod, testRS, extensionsRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[schema.GroupKind]int{
+		{Group: "", Kind: "Pod"}:            1,
+		{Group: "apps", Kind: "ReplicaSet"}: 2,
+		{Group: "apps", Kind: "Deployment"}: 1,
+	}, cluster.getResourceCountByGroupKind())
+}
+
+func TestIterateHierarchyActionOutsideOfLock(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	count := 0
+	cluster.iterateHierarchy(kube.GetResourceKey(testDeploy), func(child appv1.ResourceNode, appName string) {
+		// modifying cache from the action would deadlock if action is executed under the lock
+		cluster.processEvent(watch.Modified, testPod)
+		count++
+	})
+	assert.Equal(t, 3, count)
+}
+
+func TestGetDanglingResources(t *testing.T) {
+	orphanRS := testRS.DeepCopy()
+	orphanRS.SetName("orphan-rs")
+	orphanRS.SetUID("orphan-rs")
+	orphanRS.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "deleted-deploy", UID: "deleted-deploy"}})
+	cluster := newCluster(testPod, testRS, testDeploy, orphanRS)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	dangling := cluster.getDanglingResources("default")
+	assert.Len(t, dangling, 1)
+	assert.Equal(t, "orphan-rs", dangling[0].Name)
+	assert.Empty(t, cluster.getDanglingResources("other"))
+}
+
+// dynamicClientConfigsKubectl records the QPS of every config used to create a dynamic client
+type dynamicClientConfigsKubectl struct {
+	*kubetest.MockKubectlCmd
+	lock sync.Mutex
+	qps  []float32
+}
+
+func (k *dynamicClientConfigsKubectl) NewDynamicClient(config *rest.Config) (dynamic.Interface, error) {
+	k.lock.Lock()
+	k.qps = append(k.qps, config.QPS)
+	k.lock.Unlock()
+	return k.MockKubectlCmd.NewDynamicClient(config)
+}
+
+func TestListAndWatchConfigTweaks(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	kubectl := &dynamicClientConfigsKubectl{MockKubectlCmd: cluster.kubectl.(*kubetest.MockKubectlCmd)}
+	cluster.kubectl = kubectl
+	cluster.listConfigTweak = func(config *rest.Config) {
+		config.QPS = 100
+	}
+	cluster.watchConfigTweak = func(config *rest.Config) {
+		config.QPS = 5
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	kubectl.lock.Lock()
+	assert.Equal(t, []float32{100, 5}, kubectl.qps)
+	kubectl.lock.Unlock()
+
+	// tweaks must not leak into the shared cluster config
+	assert.NotEqual(t, float32(100), cluster.restConfig().QPS)
+	assert.NotEqual(t, float32(5), cluster.restConfig().QPS)
+}
+
+func TestDumpResources(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	resources := cluster.dumpResources()
+	assert.Len(t, resources, 3)
+	pod := resources[kube.GetResourceKey(testPod)]
+	assert.Equal(t, testPod.GetName(), pod.Ref.Name)
+	assert.Equal(t, testPod.GetResourceVersion(), pod.ResourceVersion)
+	assert.Len(t, pod.OwnerRefs, 1)
+	assert.True(t, resources[kube.GetResourceKey(testDeploy)].ManifestCached)
+
+	// modifying the snapshot must not affect the cache
+	pod.OwnerRefs[0].Name = "modified"
+	pod.Ref.Name = "modified"
+	node := cluster.nodes[kube.GetResourceKey(testPod)]
+	assert.Equal(t, testRS.GetName(), node.ownerRefs[0].Name)
+	assert.Equal(t, testPod.GetName(), node.ref.Name)
+}
+
+func TestPanickingObjectUpdatedHandler(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	podWatch := watch.NewFake()
+	client.PrependWatchReactor("pods", func(action testcore.Action) (bool, watch.Interface, error) {
+		return true, podWatch, nil
+	})
+	var lock sync.Mutex
+	var updated []string
+	cluster.onObjectUpdated = func(managedByApp map[string]bool, ref corev1.ObjectReference, event watch.EventType) {
+		if ref.Name == "poison" {
+			panic("poison object")
+		}
+		lock.Lock()
+		updated = append(updated, ref.Name)
+		lock.Unlock()
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	poison := testPod.DeepCopy()
+	poison.SetName("poison")
+	poison.SetUID("poison")
+	podWatch.Add(poison)
+	other := testPod.DeepCopy()
+	other.SetName("other")
+	other.SetUID("other")
+	podWatch.Add(other)
+
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(updated) > 0 && updated[len(updated)-1] == "other", nil
+	})
+	assert.Nil(t, err)
+
+	// the watch has not been restarted and the cache is still synced
+	assert.Empty(t, cluster.getWatchStatus())
+	_, ok := cluster.getResource(kube.GetResourceKey(poison))
+	assert.True(t, ok)
+	assert.Nil(t, cluster.ensureSynced())
+}
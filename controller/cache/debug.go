@@ -0,0 +1,274 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+const (
+	// ResourcesDumpPath is the endpoint which serves the cached resources of the cluster specified by the server query parameter
+	ResourcesDumpPath = "/debug/cache/resources"
+	// DiagnosticsPath is the endpoint which serves the caching state of the cluster specified by the server query parameter
+	DiagnosticsPath = "/debug/cache/diagnostics"
+	// WatchConfigPath is the endpoint which serves the resolved watch configuration of the cluster
+	WatchConfigPath = "/debug/cache/watch-config"
+	// StaleResourcesPath is the endpoint which serves keys of the resources not confirmed within the olderThan period
+	StaleResourcesPath = "/debug/cache/stale-resources"
+	// DanglingResourcesPath is the endpoint which serves resources of the namespace which owners are not cached
+	DanglingResourcesPath = "/debug/cache/dangling-resources"
+	// NamespacesPath is the endpoint which serves cached namespaces of the cluster on GET and changes the set of cached
+	// namespaces to the values of the namespace query parameter on POST
+	NamespacesPath = "/debug/cache/namespaces"
+	// InvalidateNamespacePath is the endpoint which re-lists resources of the namespace on POST
+	InvalidateNamespacePath = "/debug/cache/invalidate-namespace"
+	// EvictKindPath is the endpoint which stops caching of the kind specified by the group and kind query parameters on POST
+	EvictKindPath = "/debug/cache/evict-kind"
+	// UnevictKindPath is the endpoint which resumes caching of the previously evicted kind on POST
+	UnevictKindPath = "/debug/cache/unevict-kind"
+	// RefreshKindPath is the endpoint which re-lists resources of the kind on POST
+	RefreshKindPath = "/debug/cache/refresh-kind"
+)
+
+// ClusterDiagnostics is the caching state of a cluster. Kinds are keyed by the group kind string.
+type ClusterDiagnostics struct {
+	SyncStatus          SyncStatus                `json:"syncStatus"`
+	SyncErrors          map[string]string         `json:"syncErrors,omitempty"`
+	CacheGeneration     uint64                    `json:"cacheGeneration"`
+	EventProcessingLag  string                    `json:"eventProcessingLag"`
+	Kinds               map[string]KindStatus     `json:"kinds"`
+	EventStats          map[string]EventStats     `json:"eventStats,omitempty"`
+	WatchBytesReceived  map[string]int64          `json:"watchBytesReceived,omitempty"`
+	DeprecationWarnings map[string]string         `json:"deprecationWarnings,omitempty"`
+	EmptyWatchedKinds   []string                  `json:"emptyWatchedKinds,omitempty"`
+	OversizedResources  []string                  `json:"oversizedResources,omitempty"`
+	ResourceCounts      map[string]int            `json:"resourceCounts"`
+	Inventory           map[string]map[string]int `json:"inventory"`
+}
+
+// badRequestError is returned by debug handlers if the request parameters are invalid
+type badRequestError struct {
+	error
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return &badRequestError{fmt.Errorf(format, args...)}
+}
+
+// NewDebugHandler returns the handler which serves the debug endpoints of the live state cache. The endpoints expose
+// cached resources of every managed cluster and allow changing the caching state without authentication, so the
+// handler must be served on localhost only.
+func NewDebugHandler(cache LiveStateCache) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(ResourcesDumpPath, NewResourcesDumpHandler(cache.DumpResources))
+	mux.Handle(DiagnosticsPath, newClusterHandler(http.MethodGet, func(server string, query url.Values) (interface{}, error) {
+		return getClusterDiagnostics(cache, server, query.Get("resetEventStats") == "true")
+	}))
+	mux.Handle(WatchConfigPath, newClusterHandler(http.MethodGet, func(server string, query url.Values) (interface{}, error) {
+		return cache.DumpWatchConfig(server)
+	}))
+	mux.Handle(StaleResourcesPath, newClusterHandler(http.MethodGet, func(server string, query url.Values) (interface{}, error) {
+		olderThan, err := time.ParseDuration(query.Get("olderThan"))
+		if err != nil {
+			return nil, badRequest("invalid olderThan query parameter: %v", err)
+		}
+		keys, err := cache.GetStaleResources(server, olderThan)
+		if err != nil {
+			return nil, err
+		}
+		return resourceKeysToStrings(keys), nil
+	}))
+	mux.Handle(DanglingResourcesPath, newClusterHandler(http.MethodGet, func(server string, query url.Values) (interface{}, error) {
+		return cache.GetDanglingResources(server, query.Get("namespace"))
+	}))
+	namespacesGet := newClusterHandler(http.MethodGet, func(server string, query url.Values) (interface{}, error) {
+		return cache.GetNamespaces(server)
+	})
+	namespacesUpdate := newClusterHandler(http.MethodPost, func(server string, query url.Values) (interface{}, error) {
+		return nil, cache.UpdateNamespaces(server, query["namespace"])
+	})
+	mux.Handle(NamespacesPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			namespacesUpdate.ServeHTTP(w, r)
+		} else {
+			namespacesGet.ServeHTTP(w, r)
+		}
+	}))
+	mux.Handle(InvalidateNamespacePath, newClusterHandler(http.MethodPost, func(server string, query url.Values) (interface{}, error) {
+		namespace := query.Get("namespace")
+		if namespace == "" {
+			return nil, badRequest("namespace query parameter is required")
+		}
+		return nil, cache.InvalidateNamespace(server, namespace)
+	}))
+	mux.Handle(EvictKindPath, newKindHandler(cache.EvictKind))
+	mux.Handle(UnevictKindPath, newKindHandler(cache.UnevictKind))
+	mux.Handle(RefreshKindPath, newKindHandler(cache.RefreshKind))
+	return mux
+}
+
+// NewResourcesDumpHandler returns the handler which serves a JSON dump of the cached resources of a cluster. The dump
+// is keyed by the resource key and helps investigating stale resource trees.
+func NewResourcesDumpHandler(dump func(server string) (map[kube.ResourceKey]ResourceSnapshot, error)) http.Handler {
+	return newClusterHandler(http.MethodGet, func(server string, query url.Values) (interface{}, error) {
+		resources, err := dump(server)
+		if err != nil {
+			return nil, err
+		}
+		res := make(map[string]ResourceSnapshot, len(resources))
+		for key, snapshot := range resources {
+			res[key.String()] = snapshot
+		}
+		return res, nil
+	})
+}
+
+// newClusterHandler returns the handler which serves the JSON encoded result of the given function for the cluster
+// specified by the server query parameter. Handlers which return nil result respond with no content.
+func newClusterHandler(method string, handle func(server string, query url.Values) (interface{}, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			http.Error(w, fmt.Sprintf("method %s is not allowed", r.Method), http.StatusMethodNotAllowed)
+			return
+		}
+		query := r.URL.Query()
+		server := query.Get("server")
+		if server == "" {
+			http.Error(w, "server query parameter is required", http.StatusBadRequest)
+			return
+		}
+		res, err := handle(server, query)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if _, ok := err.(*badRequestError); ok {
+				status = http.StatusBadRequest
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+		if res == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			log.Warnf("Failed to write response of %s for cluster %s: %v", r.URL.Path, server, err)
+		}
+	})
+}
+
+// newKindHandler returns the handler which applies the given action to the kind specified by the group and kind query
+// parameters
+func newKindHandler(action func(server string, gk schema.GroupKind) error) http.Handler {
+	return newClusterHandler(http.MethodPost, func(server string, query url.Values) (interface{}, error) {
+		gk := schema.GroupKind{Group: query.Get("group"), Kind: query.Get("kind")}
+		if gk.Kind == "" {
+			return nil, badRequest("kind query parameter is required")
+		}
+		return nil, action(server, gk)
+	})
+}
+
+func getClusterDiagnostics(cache LiveStateCache, server string, resetEventStats bool) (*ClusterDiagnostics, error) {
+	res := &ClusterDiagnostics{
+		SyncErrors:          make(map[string]string),
+		Kinds:               make(map[string]KindStatus),
+		EventStats:          make(map[string]EventStats),
+		WatchBytesReceived:  make(map[string]int64),
+		DeprecationWarnings: make(map[string]string),
+		ResourceCounts:      make(map[string]int),
+		Inventory:           make(map[string]map[string]int),
+	}
+	var err error
+	if res.SyncStatus, err = cache.GetSyncStatus(server); err != nil {
+		return nil, err
+	}
+	syncErrors, err := cache.GetSyncErrors(server)
+	if err != nil {
+		return nil, err
+	}
+	for gk, syncErr := range syncErrors {
+		res.SyncErrors[gk.String()] = syncErr.Error()
+	}
+	if res.CacheGeneration, err = cache.GetCacheGeneration(server); err != nil {
+		return nil, err
+	}
+	lag, err := cache.GetEventProcessingLag(server)
+	if err != nil {
+		return nil, err
+	}
+	res.EventProcessingLag = lag.String()
+	kinds, err := cache.GetKindStatuses(server)
+	if err != nil {
+		return nil, err
+	}
+	for gk, status := range kinds {
+		res.Kinds[gk.String()] = status
+	}
+	eventStats, err := cache.GetEventStats(server, resetEventStats)
+	if err != nil {
+		return nil, err
+	}
+	for gk, stats := range eventStats {
+		res.EventStats[gk.String()] = stats
+	}
+	watchBytes, err := cache.GetWatchBytesReceived(server)
+	if err != nil {
+		return nil, err
+	}
+	for gk, bytes := range watchBytes {
+		res.WatchBytesReceived[gk.String()] = bytes
+	}
+	warnings, err := cache.GetDeprecationWarnings(server)
+	if err != nil {
+		return nil, err
+	}
+	for gk, warning := range warnings {
+		res.DeprecationWarnings[gk.String()] = warning
+	}
+	emptyKinds, err := cache.GetEmptyWatchedKinds(server)
+	if err != nil {
+		return nil, err
+	}
+	for _, gk := range emptyKinds {
+		res.EmptyWatchedKinds = append(res.EmptyWatchedKinds, gk.String())
+	}
+	oversized, err := cache.GetOversizedResources(server)
+	if err != nil {
+		return nil, err
+	}
+	res.OversizedResources = resourceKeysToStrings(oversized)
+	counts, err := cache.GetResourceCountByGroupKind(server)
+	if err != nil {
+		return nil, err
+	}
+	for gk, count := range counts {
+		res.ResourceCounts[gk.String()] = count
+	}
+	inventory, err := cache.GetInventoryMatrix(server)
+	if err != nil {
+		return nil, err
+	}
+	for namespace, namespaceCounts := range inventory {
+		res.Inventory[namespace] = make(map[string]int, len(namespaceCounts))
+		for gk, count := range namespaceCounts {
+			res.Inventory[namespace][gk.String()] = count
+		}
+	}
+	return res, nil
+}
+
+func resourceKeysToStrings(keys []kube.ResourceKey) []string {
+	res := make([]string, len(keys))
+	for i := range keys {
+		res[i] = keys[i].String()
+	}
+	return res
+}
@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+func TestResourcesDumpHandler(t *testing.T) {
+	key := kube.NewResourceKey("apps", "Deployment", "default", "helm-guestbook")
+	handler := NewResourcesDumpHandler(func(server string) (map[kube.ResourceKey]ResourceSnapshot, error) {
+		if server != "https://test" {
+			return nil, fmt.Errorf("cluster %s not found", server)
+		}
+		return map[kube.ResourceKey]ResourceSnapshot{key: {Ref: v1.ObjectReference{Name: "helm-guestbook"}, ResourceVersion: "123", ManifestCached: true}}, nil
+	})
+
+	t.Run("Dump", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ResourcesDumpPath+"?server=https://test", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var res map[string]ResourceSnapshot
+		assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.Equal(t, "123", res[key.String()].ResourceVersion)
+		assert.True(t, res[key.String()].ManifestCached)
+	})
+
+	t.Run("MissingServer", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ResourcesDumpPath, nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("UnknownServer", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ResourcesDumpPath+"?server=https://unknown", nil))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), "not found")
+	})
+}
+
+func TestDebugHandler(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	handler := NewDebugHandler(&liveStateCache{
+		lock:     &sync.Mutex{},
+		clusters: map[string]*clusterInfo{cluster.cluster.Server: cluster},
+	})
+	serve := func(method string, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec
+	}
+	server := "?server=" + cluster.cluster.Server
+
+	t.Run("Diagnostics", func(t *testing.T) {
+		rec := serve(http.MethodGet, DiagnosticsPath+server)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var res ClusterDiagnostics
+		assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.Equal(t, Synced, res.SyncStatus)
+		assert.True(t, res.Kinds["Pod"].Listed)
+		assert.Equal(t, 1, res.ResourceCounts["ReplicaSet.apps"])
+		assert.Equal(t, 1, res.Inventory["default"]["Deployment.apps"])
+	})
+
+	t.Run("StaleResources", func(t *testing.T) {
+		rec := serve(http.MethodGet, StaleResourcesPath+server+"&olderThan=1h")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		rec = serve(http.MethodGet, StaleResourcesPath+server+"&olderThan=foo")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("EvictKind", func(t *testing.T) {
+		rec := serve(http.MethodGet, EvictKindPath+server+"&group=apps&kind=ReplicaSet")
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+		rec = serve(http.MethodPost, EvictKindPath+server+"&group=apps")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = serve(http.MethodPost, EvictKindPath+server+"&group=apps&kind=ReplicaSet")
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		_, ok := cluster.getResource(kube.GetResourceKey(testRS))
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"k8s.io/apimachinery/pkg/watch"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// ResourceEvent describes a change of the cached resource. New is nil for deleted resources and Old is nil for added resources.
+type ResourceEvent struct {
+	Type watch.EventType
+	New  *appv1.ResourceNode
+	Old  *appv1.ResourceNode
+}
+
+// EventsOverflowPolicy defines which event is dropped when the events channel buffer is full
+type EventsOverflowPolicy string
+
+const (
+	// DropNewestEvent drops the event which does not fit into the buffer
+	DropNewestEvent EventsOverflowPolicy = "DropNewest"
+	// DropOldestEvent drops the oldest buffered event to make room for the new one, so the consumer catches up with the
+	// latest changes
+	DropOldestEvent EventsOverflowPolicy = "DropOldest"
+)
+
+type eventsSubscriber struct {
+	events chan ResourceEvent
+	policy EventsOverflowPolicy
+}
+
+// subscribeEvents returns the channel which receives all changes of the cached resources and the function which cancels
+// the subscription and closes the channel. Events are delivered without blocking the cache: if the channel buffer is full
+// then the event is dropped according to the given policy and the number of dropped events is available via
+// droppedEvents. Empty policy means DropNewestEvent.
+func (c *clusterInfo) subscribeEvents(buffer int, policy EventsOverflowPolicy) (<-chan ResourceEvent, func()) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	subscriber := &eventsSubscriber{events: make(chan ResourceEvent, buffer), policy: policy}
+	c.eventsSubscribers = append(c.eventsSubscribers, subscriber)
+	return subscriber.events, func() {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		for i := range c.eventsSubscribers {
+			if c.eventsSubscribers[i] == subscriber {
+				c.eventsSubscribers = append(c.eventsSubscribers[:i], c.eventsSubscribers[i+1:]...)
+				close(subscriber.events)
+				return
+			}
+		}
+	}
+}
+
+// droppedEvents returns the number of events dropped because subscribers did not keep up with the events rate. The
+// number never decreases, so it can be exported as a counter.
+func (c *clusterInfo) droppedEvents() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.droppedEventsCount
+}
+
+func (c *clusterInfo) publishEvent(eventType watch.EventType, newNode *node, oldNode *node) {
+	if len(c.eventsSubscribers) == 0 {
+		return
+	}
+	event := ResourceEvent{Type: eventType}
+	if newNode != nil {
+		resNode := newNode.asResourceNode()
+		event.New = &resNode
+	}
+	if oldNode != nil {
+		resNode := oldNode.asResourceNode()
+		event.Old = &resNode
+	}
+	for _, subscriber := range c.eventsSubscribers {
+		if !subscriber.publish(event) {
+			c.droppedEventsCount++
+		}
+	}
+}
+
+// publish sends the event to the subscriber without blocking. Returns false if an event has been dropped.
+func (s *eventsSubscriber) publish(event ResourceEvent) bool {
+	select {
+	case s.events <- event:
+		return true
+	default:
+	}
+	if s.policy != DropOldestEvent {
+		return false
+	}
+	// the consumer might receive the oldest event concurrently, so the channel might have room already
+	select {
+	case <-s.events:
+	default:
+	}
+	select {
+	case s.events <- event:
+	default:
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"sync"
+
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+// handlerReentranceError is the panic value raised when the cluster cache is accessed from a handler which is invoked
+// under the cluster lock
+type handlerReentranceError struct {
+	handler string
+	server  string
+}
+
+func (e handlerReentranceError) Error() string {
+	return fmt.Sprintf("cluster cache %s is accessed from the %s handler which is invoked under the cluster lock: the call would deadlock", e.server, e.handler)
+}
+
+// handlerTracker records goroutines which invoke handlers while holding the cluster lock
+type handlerTracker struct {
+	lock       sync.Mutex
+	goroutines map[uint64]string
+}
+
+// enter records that the current goroutine invokes the given handler and returns the function which removes the record
+func (t *handlerTracker) enter(handler string) func() {
+	id := goroutineID()
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.goroutines == nil {
+		t.goroutines = make(map[uint64]string)
+	}
+	prev, nested := t.goroutines[id]
+	t.goroutines[id] = handler
+	return func() {
+		t.lock.Lock()
+		defer t.lock.Unlock()
+		if nested {
+			t.goroutines[id] = prev
+		} else {
+			delete(t.goroutines, id)
+		}
+	}
+}
+
+// current returns the handler invoked by the current goroutine
+func (t *handlerTracker) current() (string, bool) {
+	id := goroutineID()
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	handler, ok := t.goroutines[id]
+	return handler, ok
+}
+
+// goroutineID returns the id of the current goroutine parsed from the stack trace header, e.g. "goroutine 42 [running]:"
+func goroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	if i := bytes.IndexByte(buf, ' '); i >= 0 {
+		buf = buf[:i]
+	}
+	id, _ := strconv.ParseUint(string(buf), 10, 64)
+	return id
+}
+
+// invokeHandler invokes the handler of the given resource change which runs under the cluster lock. Handler panic is
+// logged and swallowed, so that a single resource cannot break the watch of the whole kind. If lock debugging is
+// enabled the goroutine is recorded so that accessing the cache from the handler panics instead of silently deadlocking.
+func (c *clusterInfo) invokeHandler(name string, key kube.ResourceKey, handler func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(handlerReentranceError); ok {
+				panic(r)
+			}
+			c.log.Errorf("recovered from panic of %s handler of %s: %+v\n%s", name, key, r, debug.Stack())
+		}
+	}()
+	if c.debugLocking {
+		release := c.handlerTracker.enter(name)
+		defer release()
+	}
+	handler()
+}
+
+// checkHandlerReentrance panics if lock debugging is enabled and the cache is accessed from a handler invoked under the
+// cluster lock
+func (c *clusterInfo) checkHandlerReentrance() {
+	if !c.debugLocking {
+		return
+	}
+	if handler, ok := c.handlerTracker.current(); ok {
+		panic(handlerReentranceError{handler: handler, server: c.cluster.Server})
+	}
+}
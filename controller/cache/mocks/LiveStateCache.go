@@ -5,55 +5,598 @@ package mocks
 import (
 	context "context"
 
+	cache "github.com/argoproj/argo-cd/controller/cache"
+
+	diff "github.com/argoproj/argo-cd/util/diff"
+
 	metrics "github.com/argoproj/argo-cd/controller/metrics"
 	kube "github.com/argoproj/argo-cd/util/kube"
 
-	mock "github.com/stretchr/testify/mock"
+	labels "k8s.io/apimachinery/pkg/labels"
+
+	mock "github.com/stretchr/testify/mock"
+
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+
+	time "time"
+
+	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	v1alpha1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// LiveStateCache is an autogenerated mock type for the LiveStateCache type
+type LiveStateCache struct {
+	mock.Mock
+}
+
+// ChangesSince provides a mock function with given fields: server, token
+func (_m *LiveStateCache) ChangesSince(server string, token string) ([]kube.ResourceKey, string, error) {
+	ret := _m.Called(server, token)
+
+	var r0 []kube.ResourceKey
+	if rf, ok := ret.Get(0).(func(string, string) []kube.ResourceKey); ok {
+		r0 = rf(server, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]kube.ResourceKey)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(string, string) string); ok {
+		r1 = rf(server, token)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string) error); ok {
+		r2 = rf(server, token)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ComputeLiveTargetPatches provides a mock function with given fields: a, targetObjs
+func (_m *LiveStateCache) ComputeLiveTargetPatches(a *v1alpha1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey][]byte, error) {
+	ret := _m.Called(a, targetObjs)
+
+	var r0 map[kube.ResourceKey][]byte
+	if rf, ok := ret.Get(0).(func(*v1alpha1.Application, []*unstructured.Unstructured) map[kube.ResourceKey][]byte); ok {
+		r0 = rf(a, targetObjs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[kube.ResourceKey][]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1alpha1.Application, []*unstructured.Unstructured) error); ok {
+		r1 = rf(a, targetObjs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DumpResources provides a mock function with given fields: server
+func (_m *LiveStateCache) DumpResources(server string) (map[kube.ResourceKey]cache.ResourceSnapshot, error) {
+	ret := _m.Called(server)
+
+	var r0 map[kube.ResourceKey]cache.ResourceSnapshot
+	if rf, ok := ret.Get(0).(func(string) map[kube.ResourceKey]cache.ResourceSnapshot); ok {
+		r0 = rf(server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[kube.ResourceKey]cache.ResourceSnapshot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DumpWatchConfig provides a mock function with given fields: server
+func (_m *LiveStateCache) DumpWatchConfig(server string) (cache.WatchConfig, error) {
+	ret := _m.Called(server)
+
+	var r0 cache.WatchConfig
+	if rf, ok := ret.Get(0).(func(string) cache.WatchConfig); ok {
+		r0 = rf(server)
+	} else {
+		r0 = ret.Get(0).(cache.WatchConfig)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Events provides a mock function with given fields: server, buffer, policy
+func (_m *LiveStateCache) Events(server string, buffer int, policy cache.EventsOverflowPolicy) (<-chan cache.ResourceEvent, func(), error) {
+	ret := _m.Called(server, buffer, policy)
+
+	var r0 <-chan cache.ResourceEvent
+	if rf, ok := ret.Get(0).(func(string, int, cache.EventsOverflowPolicy) <-chan cache.ResourceEvent); ok {
+		r0 = rf(server, buffer, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan cache.ResourceEvent)
+		}
+	}
+
+	var r1 func()
+	if rf, ok := ret.Get(1).(func(string, int, cache.EventsOverflowPolicy) func()); ok {
+		r1 = rf(server, buffer, policy)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, int, cache.EventsOverflowPolicy) error); ok {
+		r2 = rf(server, buffer, policy)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EvictKind provides a mock function with given fields: server, gk
+func (_m *LiveStateCache) EvictKind(server string, gk schema.GroupKind) error {
+	ret := _m.Called(server, gk)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, schema.GroupKind) error); ok {
+		r0 = rf(server, gk)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetCacheGeneration provides a mock function with given fields: server
+func (_m *LiveStateCache) GetCacheGeneration(server string) (uint64, error) {
+	ret := _m.Called(server)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(string) uint64); ok {
+		r0 = rf(server)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClustersInfo provides a mock function with given fields:
+func (_m *LiveStateCache) GetClustersInfo() []metrics.ClusterInfo {
+	ret := _m.Called()
+
+	var r0 []metrics.ClusterInfo
+	if rf, ok := ret.Get(0).(func() []metrics.ClusterInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]metrics.ClusterInfo)
+		}
+	}
+
+	return r0
+}
+
+// GetControllerChildren provides a mock function with given fields: server, key
+func (_m *LiveStateCache) GetControllerChildren(server string, key kube.ResourceKey) ([]v1alpha1.ResourceNode, error) {
+	ret := _m.Called(server, key)
+
+	var r0 []v1alpha1.ResourceNode
+	if rf, ok := ret.Get(0).(func(string, kube.ResourceKey) []v1alpha1.ResourceNode); ok {
+		r0 = rf(server, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v1alpha1.ResourceNode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, kube.ResourceKey) error); ok {
+		r1 = rf(server, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDanglingResources provides a mock function with given fields: server, namespace
+func (_m *LiveStateCache) GetDanglingResources(server string, namespace string) ([]v1alpha1.ResourceNode, error) {
+	ret := _m.Called(server, namespace)
+
+	var r0 []v1alpha1.ResourceNode
+	if rf, ok := ret.Get(0).(func(string, string) []v1alpha1.ResourceNode); ok {
+		r0 = rf(server, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v1alpha1.ResourceNode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(server, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeprecationWarnings provides a mock function with given fields: server
+func (_m *LiveStateCache) GetDeprecationWarnings(server string) (map[schema.GroupKind]string, error) {
+	ret := _m.Called(server)
+
+	var r0 map[schema.GroupKind]string
+	if rf, ok := ret.Get(0).(func(string) map[schema.GroupKind]string); ok {
+		r0 = rf(server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[schema.GroupKind]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDroppedEvents provides a mock function with given fields: server
+func (_m *LiveStateCache) GetDroppedEvents(server string) (int, error) {
+	ret := _m.Called(server)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(server)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEmptyWatchedKinds provides a mock function with given fields: server
+func (_m *LiveStateCache) GetEmptyWatchedKinds(server string) ([]schema.GroupKind, error) {
+	ret := _m.Called(server)
+
+	var r0 []schema.GroupKind
+	if rf, ok := ret.Get(0).(func(string) []schema.GroupKind); ok {
+		r0 = rf(server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schema.GroupKind)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEventProcessingLag provides a mock function with given fields: server
+func (_m *LiveStateCache) GetEventProcessingLag(server string) (time.Duration, error) {
+	ret := _m.Called(server)
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func(string) time.Duration); ok {
+		r0 = rf(server)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEventStats provides a mock function with given fields: server, reset
+func (_m *LiveStateCache) GetEventStats(server string, reset bool) (map[schema.GroupKind]cache.EventStats, error) {
+	ret := _m.Called(server, reset)
+
+	var r0 map[schema.GroupKind]cache.EventStats
+	if rf, ok := ret.Get(0).(func(string, bool) map[schema.GroupKind]cache.EventStats); ok {
+		r0 = rf(server, reset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[schema.GroupKind]cache.EventStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(server, reset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInventoryMatrix provides a mock function with given fields: server
+func (_m *LiveStateCache) GetInventoryMatrix(server string) (map[string]map[schema.GroupKind]int, error) {
+	ret := _m.Called(server)
+
+	var r0 map[string]map[schema.GroupKind]int
+	if rf, ok := ret.Get(0).(func(string) map[string]map[schema.GroupKind]int); ok {
+		r0 = rf(server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[schema.GroupKind]int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKindStatuses provides a mock function with given fields: server
+func (_m *LiveStateCache) GetKindStatuses(server string) (map[schema.GroupKind]cache.KindStatus, error) {
+	ret := _m.Called(server)
+
+	var r0 map[schema.GroupKind]cache.KindStatus
+	if rf, ok := ret.Get(0).(func(string) map[schema.GroupKind]cache.KindStatus); ok {
+		r0 = rf(server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[schema.GroupKind]cache.KindStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetManagedLiveObjs provides a mock function with given fields: a, targetObjs
+func (_m *LiveStateCache) GetManagedLiveObjs(a *v1alpha1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	ret := _m.Called(a, targetObjs)
+
+	var r0 map[kube.ResourceKey]*unstructured.Unstructured
+	if rf, ok := ret.Get(0).(func(*v1alpha1.Application, []*unstructured.Unstructured) map[kube.ResourceKey]*unstructured.Unstructured); ok {
+		r0 = rf(a, targetObjs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[kube.ResourceKey]*unstructured.Unstructured)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1alpha1.Application, []*unstructured.Unstructured) error); ok {
+		r1 = rf(a, targetObjs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetManagedLiveObjsContext provides a mock function with given fields: ctx, a, targetObjs
+func (_m *LiveStateCache) GetManagedLiveObjsContext(ctx context.Context, a *v1alpha1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	ret := _m.Called(ctx, a, targetObjs)
+
+	var r0 map[kube.ResourceKey]*unstructured.Unstructured
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, []*unstructured.Unstructured) map[kube.ResourceKey]*unstructured.Unstructured); ok {
+		r0 = rf(ctx, a, targetObjs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[kube.ResourceKey]*unstructured.Unstructured)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.Application, []*unstructured.Unstructured) error); ok {
+		r1 = rf(ctx, a, targetObjs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNamespaces provides a mock function with given fields: server
+func (_m *LiveStateCache) GetNamespaces(server string) ([]string, error) {
+	ret := _m.Called(server)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNamespaceTopLevelResources provides a mock function with given fields: server, namespace
+func (_m *LiveStateCache) GetNamespaceTopLevelResources(server string, namespace string) (map[kube.ResourceKey]v1alpha1.ResourceNode, error) {
+	ret := _m.Called(server, namespace)
+
+	var r0 map[kube.ResourceKey]v1alpha1.ResourceNode
+	if rf, ok := ret.Get(0).(func(string, string) map[kube.ResourceKey]v1alpha1.ResourceNode); ok {
+		r0 = rf(server, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[kube.ResourceKey]v1alpha1.ResourceNode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(server, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
 
-	schema "k8s.io/apimachinery/pkg/runtime/schema"
+// GetNormalizedManagedLiveObjs provides a mock function with given fields: a, targetObjs, normalizer
+func (_m *LiveStateCache) GetNormalizedManagedLiveObjs(a *v1alpha1.Application, targetObjs []*unstructured.Unstructured, normalizer diff.Normalizer) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	ret := _m.Called(a, targetObjs, normalizer)
 
-	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	var r0 map[kube.ResourceKey]*unstructured.Unstructured
+	if rf, ok := ret.Get(0).(func(*v1alpha1.Application, []*unstructured.Unstructured, diff.Normalizer) map[kube.ResourceKey]*unstructured.Unstructured); ok {
+		r0 = rf(a, targetObjs, normalizer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[kube.ResourceKey]*unstructured.Unstructured)
+		}
+	}
 
-	v1alpha1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
-)
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1alpha1.Application, []*unstructured.Unstructured, diff.Normalizer) error); ok {
+		r1 = rf(a, targetObjs, normalizer)
+	} else {
+		r1 = ret.Error(1)
+	}
 
-// LiveStateCache is an autogenerated mock type for the LiveStateCache type
-type LiveStateCache struct {
-	mock.Mock
+	return r0, r1
 }
 
-// GetClustersInfo provides a mock function with given fields:
-func (_m *LiveStateCache) GetClustersInfo() []metrics.ClusterInfo {
-	ret := _m.Called()
+// GetOversizedResources provides a mock function with given fields: server
+func (_m *LiveStateCache) GetOversizedResources(server string) ([]kube.ResourceKey, error) {
+	ret := _m.Called(server)
 
-	var r0 []metrics.ClusterInfo
-	if rf, ok := ret.Get(0).(func() []metrics.ClusterInfo); ok {
-		r0 = rf()
+	var r0 []kube.ResourceKey
+	if rf, ok := ret.Get(0).(func(string) []kube.ResourceKey); ok {
+		r0 = rf(server)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]metrics.ClusterInfo)
+			r0 = ret.Get(0).([]kube.ResourceKey)
 		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// GetManagedLiveObjs provides a mock function with given fields: a, targetObjs
-func (_m *LiveStateCache) GetManagedLiveObjs(a *v1alpha1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
-	ret := _m.Called(a, targetObjs)
+// GetResource provides a mock function with given fields: server, key
+func (_m *LiveStateCache) GetResource(server string, key kube.ResourceKey) (*v1alpha1.ResourceNode, bool, error) {
+	ret := _m.Called(server, key)
 
-	var r0 map[kube.ResourceKey]*unstructured.Unstructured
-	if rf, ok := ret.Get(0).(func(*v1alpha1.Application, []*unstructured.Unstructured) map[kube.ResourceKey]*unstructured.Unstructured); ok {
-		r0 = rf(a, targetObjs)
+	var r0 *v1alpha1.ResourceNode
+	if rf, ok := ret.Get(0).(func(string, kube.ResourceKey) *v1alpha1.ResourceNode); ok {
+		r0 = rf(server, key)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[kube.ResourceKey]*unstructured.Unstructured)
+			r0 = ret.Get(0).(*v1alpha1.ResourceNode)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string, kube.ResourceKey) bool); ok {
+		r1 = rf(server, key)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, kube.ResourceKey) error); ok {
+		r2 = rf(server, key)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetResourcesByLabel provides a mock function with given fields: server, selector, namespace
+func (_m *LiveStateCache) GetResourcesByLabel(server string, selector labels.Selector, namespace string) ([]v1alpha1.ResourceNode, error) {
+	ret := _m.Called(server, selector, namespace)
+
+	var r0 []v1alpha1.ResourceNode
+	if rf, ok := ret.Get(0).(func(string, labels.Selector, string) []v1alpha1.ResourceNode); ok {
+		r0 = rf(server, selector, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v1alpha1.ResourceNode)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*v1alpha1.Application, []*unstructured.Unstructured) error); ok {
-		r1 = rf(a, targetObjs)
+	if rf, ok := ret.Get(1).(func(string, labels.Selector, string) error); ok {
+		r1 = rf(server, selector, namespace)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,22 +604,22 @@ func (_m *LiveStateCache) GetManagedLiveObjs(a *v1alpha1.Application, targetObjs
 	return r0, r1
 }
 
-// GetNamespaceTopLevelResources provides a mock function with given fields: server, namespace
-func (_m *LiveStateCache) GetNamespaceTopLevelResources(server string, namespace string) (map[kube.ResourceKey]v1alpha1.ResourceNode, error) {
-	ret := _m.Called(server, namespace)
+// GetResourceCountByGroupKind provides a mock function with given fields: server
+func (_m *LiveStateCache) GetResourceCountByGroupKind(server string) (map[schema.GroupKind]int, error) {
+	ret := _m.Called(server)
 
-	var r0 map[kube.ResourceKey]v1alpha1.ResourceNode
-	if rf, ok := ret.Get(0).(func(string, string) map[kube.ResourceKey]v1alpha1.ResourceNode); ok {
-		r0 = rf(server, namespace)
+	var r0 map[schema.GroupKind]int
+	if rf, ok := ret.Get(0).(func(string) map[schema.GroupKind]int); ok {
+		r0 = rf(server)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[kube.ResourceKey]v1alpha1.ResourceNode)
+			r0 = ret.Get(0).(map[schema.GroupKind]int)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(server, namespace)
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -105,11 +648,138 @@ func (_m *LiveStateCache) GetServerVersion(serverURL string) (string, error) {
 	return r0, r1
 }
 
+// GetSortedResources provides a mock function with given fields: server, namespace, less
+func (_m *LiveStateCache) GetSortedResources(server string, namespace string, less func(*v1alpha1.ResourceNode, *v1alpha1.ResourceNode) bool) ([]v1alpha1.ResourceNode, error) {
+	ret := _m.Called(server, namespace, less)
+
+	var r0 []v1alpha1.ResourceNode
+	if rf, ok := ret.Get(0).(func(string, string, func(*v1alpha1.ResourceNode, *v1alpha1.ResourceNode) bool) []v1alpha1.ResourceNode); ok {
+		r0 = rf(server, namespace, less)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v1alpha1.ResourceNode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, func(*v1alpha1.ResourceNode, *v1alpha1.ResourceNode) bool) error); ok {
+		r1 = rf(server, namespace, less)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStaleResources provides a mock function with given fields: server, olderThan
+func (_m *LiveStateCache) GetStaleResources(server string, olderThan time.Duration) ([]kube.ResourceKey, error) {
+	ret := _m.Called(server, olderThan)
+
+	var r0 []kube.ResourceKey
+	if rf, ok := ret.Get(0).(func(string, time.Duration) []kube.ResourceKey); ok {
+		r0 = rf(server, olderThan)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]kube.ResourceKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, time.Duration) error); ok {
+		r1 = rf(server, olderThan)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSyncErrors provides a mock function with given fields: server
+func (_m *LiveStateCache) GetSyncErrors(server string) (map[schema.GroupKind]error, error) {
+	ret := _m.Called(server)
+
+	var r0 map[schema.GroupKind]error
+	if rf, ok := ret.Get(0).(func(string) map[schema.GroupKind]error); ok {
+		r0 = rf(server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[schema.GroupKind]error)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSyncStatus provides a mock function with given fields: server
+func (_m *LiveStateCache) GetSyncStatus(server string) (cache.SyncStatus, error) {
+	ret := _m.Called(server)
+
+	var r0 cache.SyncStatus
+	if rf, ok := ret.Get(0).(func(string) cache.SyncStatus); ok {
+		r0 = rf(server)
+	} else {
+		r0 = ret.Get(0).(cache.SyncStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWatchBytesReceived provides a mock function with given fields: server
+func (_m *LiveStateCache) GetWatchBytesReceived(server string) (map[schema.GroupKind]int64, error) {
+	ret := _m.Called(server)
+
+	var r0 map[schema.GroupKind]int64
+	if rf, ok := ret.Get(0).(func(string) map[schema.GroupKind]int64); ok {
+		r0 = rf(server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[schema.GroupKind]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Invalidate provides a mock function with given fields:
 func (_m *LiveStateCache) Invalidate() {
 	_m.Called()
 }
 
+// InvalidateNamespace provides a mock function with given fields: server, namespace
+func (_m *LiveStateCache) InvalidateNamespace(server string, namespace string) error {
+	ret := _m.Called(server, namespace)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(server, namespace)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // IsNamespaced provides a mock function with given fields: server, gk
 func (_m *LiveStateCache) IsNamespaced(server string, gk schema.GroupKind) (bool, error) {
 	ret := _m.Called(server, gk)
@@ -131,6 +801,55 @@ func (_m *LiveStateCache) IsNamespaced(server string, gk schema.GroupKind) (bool
 	return r0, r1
 }
 
+// IsNamespacedKnown provides a mock function with given fields: server, gk
+func (_m *LiveStateCache) IsNamespacedKnown(server string, gk schema.GroupKind) (bool, bool, error) {
+	ret := _m.Called(server, gk)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, schema.GroupKind) bool); ok {
+		r0 = rf(server, gk)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string, schema.GroupKind) bool); ok {
+		r1 = rf(server, gk)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, schema.GroupKind) error); ok {
+		r2 = rf(server, gk)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// IsWatchHealthy provides a mock function with given fields: server, gk
+func (_m *LiveStateCache) IsWatchHealthy(server string, gk schema.GroupKind) (bool, error) {
+	ret := _m.Called(server, gk)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, schema.GroupKind) bool); ok {
+		r0 = rf(server, gk)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, schema.GroupKind) error); ok {
+		r1 = rf(server, gk)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IterateHierarchy provides a mock function with given fields: server, key, action
 func (_m *LiveStateCache) IterateHierarchy(server string, key kube.ResourceKey, action func(v1alpha1.ResourceNode, string)) error {
 	ret := _m.Called(server, key, action)
@@ -145,6 +864,57 @@ func (_m *LiveStateCache) IterateHierarchy(server string, key kube.ResourceKey,
 	return r0
 }
 
+// IterateHierarchyV2 provides a mock function with given fields: server, keys, action
+func (_m *LiveStateCache) IterateHierarchyV2(server string, keys []kube.ResourceKey, action func(v1alpha1.ResourceNode, string) bool) error {
+	ret := _m.Called(server, keys, action)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []kube.ResourceKey, func(v1alpha1.ResourceNode, string) bool) error); ok {
+		r0 = rf(server, keys, action)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryResources provides a mock function with given fields: server, predicate
+func (_m *LiveStateCache) QueryResources(server string, predicate func(*unstructured.Unstructured) bool) ([]v1alpha1.ResourceNode, error) {
+	ret := _m.Called(server, predicate)
+
+	var r0 []v1alpha1.ResourceNode
+	if rf, ok := ret.Get(0).(func(string, func(*unstructured.Unstructured) bool) []v1alpha1.ResourceNode); ok {
+		r0 = rf(server, predicate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v1alpha1.ResourceNode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, func(*unstructured.Unstructured) bool) error); ok {
+		r1 = rf(server, predicate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshKind provides a mock function with given fields: server, gk
+func (_m *LiveStateCache) RefreshKind(server string, gk schema.GroupKind) error {
+	ret := _m.Called(server, gk)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, schema.GroupKind) error); ok {
+		r0 = rf(server, gk)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Run provides a mock function with given fields: ctx
 func (_m *LiveStateCache) Run(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -158,3 +928,54 @@ func (_m *LiveStateCache) Run(ctx context.Context) error {
 
 	return r0
 }
+
+// SnapshotHierarchy provides a mock function with given fields: server, key
+func (_m *LiveStateCache) SnapshotHierarchy(server string, key kube.ResourceKey) ([]v1alpha1.ResourceNode, error) {
+	ret := _m.Called(server, key)
+
+	var r0 []v1alpha1.ResourceNode
+	if rf, ok := ret.Get(0).(func(string, kube.ResourceKey) []v1alpha1.ResourceNode); ok {
+		r0 = rf(server, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v1alpha1.ResourceNode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, kube.ResourceKey) error); ok {
+		r1 = rf(server, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnevictKind provides a mock function with given fields: server, gk
+func (_m *LiveStateCache) UnevictKind(server string, gk schema.GroupKind) error {
+	ret := _m.Called(server, gk)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, schema.GroupKind) error); ok {
+		r0 = rf(server, gk)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateNamespaces provides a mock function with given fields: server, namespaces
+func (_m *LiveStateCache) UpdateNamespaces(server string, namespaces []string) error {
+	ret := _m.Called(server, namespaces)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(server, namespaces)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -1,6 +1,9 @@
 package cache
 
 import (
+	"sync"
+	"time"
+
 	log "github.com/sirupsen/logrus"
 
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -16,14 +19,61 @@ type node struct {
 	resourceVersion string
 	ref             v1.ObjectReference
 	ownerRefs       []metav1.OwnerReference
-	info            []appv1.InfoItem
-	appName         string
+	// labels are retained even if the resource manifest is not cached, so resources can be queried by label
+	labels  map[string]string
+	info    []appv1.InfoItem
+	appName string
 	// available only for root application nodes
 	resource *unstructured.Unstructured
+	// oversized is true if the manifest of the root application node is not cached because it exceeds the size limit
+	oversized bool
 	// networkingInfo are available only for known types involved into networking: Ingress, Service, Pod
 	networkingInfo *appv1.ResourceNetworkingInfo
 	images         []string
 	health         *appv1.HealthStatus
+	// contentHash is the hash of the resource fields which are meaningful to the cache consumers. It is computed only if
+	// no-op updates are skipped.
+	contentHash uint64
+	// lastUpdated is the time when the node has been created from the listed or watched resource
+	lastUpdated time.Time
+	// conversions caches the resource converted to other versions. The node is replaced whenever the resource version
+	// changes, so cached conversions never become stale. Conversions are performed concurrently under the cache read
+	// lock, so the cache is guarded by conversionsLock.
+	conversions     map[schema.GroupVersion]*unstructured.Unstructured
+	conversionsLock sync.Mutex
+	// unpopulated holds the resource which info, networking info and images have not been computed yet. It is set only if
+	// the resource info is populated lazily. Nodes are read concurrently under the cache read lock, so the info is
+	// populated under infoOnce and readers must access the info using asResourceNode.
+	unpopulated *unstructured.Unstructured
+	infoOnce    sync.Once
+}
+
+// populateInfo computes the info of the lazily populated node on the first access and releases the resource
+func (n *node) populateInfo() {
+	n.infoOnce.Do(func() {
+		if n.unpopulated != nil {
+			populateNodeInfo(n.unpopulated, n)
+			n.unpopulated = nil
+		}
+	})
+}
+
+// convertResource returns a copy of the resource converted to the given version and caches the conversion result
+func (n *node) convertResource(kubectl kube.Kubectl, gv schema.GroupVersion) (*unstructured.Unstructured, error) {
+	n.conversionsLock.Lock()
+	defer n.conversionsLock.Unlock()
+	if converted, ok := n.conversions[gv]; ok {
+		return converted.DeepCopy(), nil
+	}
+	converted, err := kubectl.ConvertToVersion(n.resource, gv.Group, gv.Version)
+	if err != nil {
+		return nil, err
+	}
+	if n.conversions == nil {
+		n.conversions = make(map[schema.GroupVersion]*unstructured.Unstructured)
+	}
+	n.conversions[gv] = converted
+	return converted.DeepCopy(), nil
 }
 
 func (n *node) isRootAppNode() bool {
@@ -35,12 +85,14 @@ func (n *node) resourceKey() kube.ResourceKey {
 }
 
 func (n *node) isParentOf(child *node) bool {
-	for i, ownerRef := range child.ownerRefs {
-
-		// backfill UID of inferred owner child references
-		if ownerRef.UID == "" && n.ref.Kind == ownerRef.Kind && n.ref.APIVersion == ownerRef.APIVersion && n.ref.Name == ownerRef.Name {
-			ownerRef.UID = n.ref.UID
-			child.ownerRefs[i] = ownerRef
+	// resource cannot be a parent of itself
+	if n == child || n.ref.UID != "" && n.ref.UID == child.ref.UID {
+		return false
+	}
+	for _, ownerRef := range child.ownerRefs {
+		// UID of inferred owner references is backfilled once the owner is cached, see resolveInferredOwners. Nodes are
+		// read concurrently under the cache read lock, so references are matched here without modifying them.
+		if ownerRef.UID == "" && n.isInferredOwnerOf(child, ownerRef) {
 			return true
 		}
 
@@ -52,6 +104,34 @@ func (n *node) isParentOf(child *node) bool {
 	return false
 }
 
+// isInferredOwnerOf returns true if the owner reference without UID points to the node. Owner must be either in the same
+// namespace or cluster level.
+func (n *node) isInferredOwnerOf(child *node, ownerRef metav1.OwnerReference) bool {
+	return n.ref.Kind == ownerRef.Kind && n.ref.APIVersion == ownerRef.APIVersion && n.ref.Name == ownerRef.Name &&
+		(n.ref.Namespace == "" || n.ref.Namespace == child.ref.Namespace)
+}
+
+func isControllerRef(ownerRef metav1.OwnerReference) bool {
+	return ownerRef.Controller != nil && *ownerRef.Controller
+}
+
+// isControllerOf returns true if the child has controller owner reference which points to the node
+func (n *node) isControllerOf(child *node) bool {
+	if n == child {
+		return false
+	}
+	for _, ownerRef := range child.ownerRefs {
+		if !isControllerRef(ownerRef) {
+			continue
+		}
+		if ownerRef.UID != "" && n.ref.UID == ownerRef.UID ||
+			ownerRef.UID == "" && n.ref.Kind == ownerRef.Kind && n.ref.APIVersion == ownerRef.APIVersion && n.ref.Name == ownerRef.Name {
+			return true
+		}
+	}
+	return false
+}
+
 func ownerRefGV(ownerRef metav1.OwnerReference) schema.GroupVersion {
 	gv, err := schema.ParseGroupVersion(ownerRef.APIVersion)
 	if err != nil {
@@ -99,6 +179,7 @@ func newResourceKeySet(set map[kube.ResourceKey]bool, keys ...kube.ResourceKey)
 }
 
 func (n *node) asResourceNode() appv1.ResourceNode {
+	n.populateInfo()
 	gv, err := schema.ParseGroupVersion(n.ref.APIVersion)
 	if err != nil {
 		gv = schema.GroupVersion{}
@@ -127,15 +208,32 @@ func (n *node) asResourceNode() appv1.ResourceNode {
 	}
 }
 
-func (n *node) iterateChildren(ns map[kube.ResourceKey]*node, parents map[kube.ResourceKey]bool, action func(child appv1.ResourceNode, appName string)) {
+// asUnstructured returns a minimal object which includes only the fields known to the node
+func (n *node) asUnstructured() *unstructured.Unstructured {
+	un := &unstructured.Unstructured{}
+	un.SetAPIVersion(n.ref.APIVersion)
+	un.SetKind(n.ref.Kind)
+	un.SetName(n.ref.Name)
+	un.SetNamespace(n.ref.Namespace)
+	un.SetUID(n.ref.UID)
+	un.SetResourceVersion(n.resourceVersion)
+	if len(n.ownerRefs) > 0 {
+		un.SetOwnerReferences(n.ownerRefs)
+	}
+	return un
+}
+
+// iterateChildren invokes the action for each descendant of the node. The visited set holds the keys of all nodes on the
+// current path, including the node itself, and is used to break ownership cycles.
+func (n *node) iterateChildren(ns map[kube.ResourceKey]*node, visited map[kube.ResourceKey]bool, action func(child appv1.ResourceNode, appName string)) {
 	for childKey, child := range ns {
 		if n.isParentOf(ns[childKey]) {
-			if parents[childKey] {
+			if visited[childKey] {
 				key := n.resourceKey()
 				log.Warnf("Circular dependency detected. %s is child and parent of %s", childKey.String(), key.String())
 			} else {
 				action(child.asResourceNode(), child.getApp(ns))
-				child.iterateChildren(ns, newResourceKeySet(parents, n.resourceKey()), action)
+				child.iterateChildren(ns, newResourceKeySet(visited, childKey), action)
 			}
 		}
 	}
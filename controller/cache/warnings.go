@@ -0,0 +1,120 @@
+package cache
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+// apiWarnings holds the latest warning returned by the API server for each kind. It has its own lock because warnings are
+// received during the sync, which holds the cache lock.
+type apiWarnings struct {
+	lock     sync.Mutex
+	kinds    map[schema.GroupVersionResource]schema.GroupKind
+	warnings map[schema.GroupKind]string
+}
+
+// setKinds updates resources used to map the request path to the resource kind
+func (w *apiWarnings) setKinds(apis []kube.APIResourceInfo) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.kinds = make(map[schema.GroupVersionResource]schema.GroupKind, len(apis))
+	for i := range apis {
+		w.kinds[apis[i].GroupVersionResource] = apis[i].GroupKind
+	}
+}
+
+func (w *apiWarnings) record(gvr schema.GroupVersionResource, warning string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	gk, ok := w.kinds[gvr]
+	if !ok {
+		return
+	}
+	if w.warnings == nil {
+		w.warnings = make(map[schema.GroupKind]string)
+	}
+	w.warnings[gk] = warning
+}
+
+func (w *apiWarnings) get() map[schema.GroupKind]string {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	res := make(map[schema.GroupKind]string, len(w.warnings))
+	for gk, warning := range w.warnings {
+		res[gk] = warning
+	}
+	return res
+}
+
+type warningsRoundTripper struct {
+	roundTripper http.RoundTripper
+	onWarning    func(gvr schema.GroupVersionResource, warning string)
+}
+
+func (wrt *warningsRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp, err := wrt.roundTripper.RoundTrip(r)
+	if resp != nil && len(resp.Header["Warning"]) > 0 {
+		if gvr, ok := groupVersionResourceFromPath(r.URL.Path); ok {
+			for _, warning := range resp.Header["Warning"] {
+				wrt.onWarning(gvr, parseWarning(warning))
+			}
+		}
+	}
+	return resp, err
+}
+
+// addWarningsTransportWrapper adds a transport wrapper which reports warnings returned by the API server, such as warnings
+// about deprecated APIs
+func addWarningsTransportWrapper(config *rest.Config, onWarning func(gvr schema.GroupVersionResource, warning string)) *rest.Config {
+	wrap := config.WrapTransport
+	config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		if wrap != nil {
+			rt = wrap(rt)
+		}
+		return &warningsRoundTripper{roundTripper: rt, onWarning: onWarning}
+	}
+	return config
+}
+
+// groupVersionResourceFromPath returns group, version and resource of the API server request path
+// e.g. /apis/extensions/v1beta1/namespaces/default/ingresses
+func groupVersionResourceFromPath(path string) (schema.GroupVersionResource, bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	var gvr schema.GroupVersionResource
+	switch {
+	case len(parts) >= 3 && parts[0] == "api":
+		gvr.Version = parts[1]
+		parts = parts[2:]
+	case len(parts) >= 4 && parts[0] == "apis":
+		gvr.Group = parts[1]
+		gvr.Version = parts[2]
+		parts = parts[3:]
+	default:
+		return gvr, false
+	}
+	if len(parts) >= 3 && parts[0] == "namespaces" {
+		parts = parts[2:]
+	}
+	gvr.Resource = parts[0]
+	return gvr, true
+}
+
+// parseWarning returns the text of the warning header value formatted as '<code> <agent> "<text>"'
+func parseWarning(header string) string {
+	start := strings.Index(header, `"`)
+	end := strings.LastIndex(header, `"`)
+	if start < 0 || end <= start {
+		return header
+	}
+	if text, err := strconv.Unquote(header[start : end+1]); err == nil {
+		return text
+	}
+	return header[start+1 : end]
+}
@@ -0,0 +1,63 @@
+package cache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+func TestGroupVersionResourceFromPath(t *testing.T) {
+	gvr, ok := groupVersionResourceFromPath("/apis/extensions/v1beta1/namespaces/default/ingresses")
+	assert.True(t, ok)
+	assert.Equal(t, schema.GroupVersionResource{Group: "extensions", Version: "v1beta1", Resource: "ingresses"}, gvr)
+
+	gvr, ok = groupVersionResourceFromPath("/api/v1/pods")
+	assert.True(t, ok)
+	assert.Equal(t, schema.GroupVersionResource{Version: "v1", Resource: "pods"}, gvr)
+
+	gvr, ok = groupVersionResourceFromPath("/api/v1/namespaces/default")
+	assert.True(t, ok)
+	assert.Equal(t, schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}, gvr)
+
+	_, ok = groupVersionResourceFromPath("/version")
+	assert.False(t, ok)
+}
+
+func TestParseWarning(t *testing.T) {
+	assert.Equal(t, "extensions/v1beta1 Ingress is deprecated", parseWarning(`299 - "extensions/v1beta1 Ingress is deprecated"`))
+	assert.Equal(t, "not formatted", parseWarning("not formatted"))
+}
+
+func TestWarningsTransportWrapper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Warning", `299 - "extensions/v1beta1 Ingress is deprecated"`)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var warnings apiWarnings
+	ingressGK := schema.GroupKind{Group: "extensions", Kind: "Ingress"}
+	warnings.setKinds([]kube.APIResourceInfo{{
+		GroupKind:            ingressGK,
+		GroupVersionResource: schema.GroupVersionResource{Group: "extensions", Version: "v1beta1", Resource: "ingresses"},
+	}})
+	config := addWarningsTransportWrapper(&rest.Config{Host: server.URL}, warnings.record)
+	transport, err := rest.TransportFor(config)
+	assert.NoError(t, err)
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL + "/apis/extensions/v1beta1/namespaces/default/ingresses")
+	assert.NoError(t, err)
+	_ = resp.Body.Close()
+	resp, err = client.Get(server.URL + "/apis/apps/v1/deployments")
+	assert.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, map[schema.GroupKind]string{ingressGK: "extensions/v1beta1 Ingress is deprecated"}, warnings.get())
+}
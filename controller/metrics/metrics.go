@@ -10,6 +10,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	applister "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
@@ -24,6 +25,10 @@ type MetricsServer struct {
 	kubectlExecPendingGauge *prometheus.GaugeVec
 	k8sRequestCounter       *prometheus.CounterVec
 	clusterEventsCounter    *prometheus.CounterVec
+	watchReconnectsCounter  *prometheus.CounterVec
+	watchStopsCounter       *prometheus.CounterVec
+	cacheOverLimitCounter   *prometheus.CounterVec
+	listDurationHistogram   *prometheus.HistogramVec
 	reconcileHistogram      *prometheus.HistogramVec
 	registry                *prometheus.Registry
 }
@@ -122,6 +127,31 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, health
 	}, descClusterDefaultLabels)
 	registry.MustRegister(clusterEventsCounter)
 
+	watchReconnectsCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_cluster_watch_reconnects_total",
+		Help: "Number of k8s resource watch reconnects.",
+	}, append(descClusterDefaultLabels, "group", "kind"))
+	registry.MustRegister(watchReconnectsCounter)
+
+	watchStopsCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_cluster_watch_stops_total",
+		Help: "Number of k8s resource watches permanently stopped.",
+	}, append(descClusterDefaultLabels, "group", "kind", "reason"))
+	registry.MustRegister(watchStopsCounter)
+
+	cacheOverLimitCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_cluster_cache_over_limit_total",
+		Help: "Number of times the number of cached resources exceeded the limit.",
+	}, descClusterDefaultLabels)
+	registry.MustRegister(cacheOverLimitCounter)
+
+	listDurationHistogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "argocd_cluster_list_duration_seconds",
+		Help:    "Duration of k8s resource list requests.",
+		Buckets: []float64{0.1, 0.25, .5, 1, 2, 4, 8, 16},
+	}, append(descClusterDefaultLabels, "group", "kind"))
+	registry.MustRegister(listDurationHistogram)
+
 	return &MetricsServer{
 		registry: registry,
 		Server: &http.Server{
@@ -134,6 +164,10 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, health
 		kubectlExecPendingGauge: kubectlExecPendingGauge,
 		reconcileHistogram:      reconcileHistogram,
 		clusterEventsCounter:    clusterEventsCounter,
+		watchReconnectsCounter:  watchReconnectsCounter,
+		watchStopsCounter:       watchStopsCounter,
+		cacheOverLimitCounter:   cacheOverLimitCounter,
+		listDurationHistogram:   listDurationHistogram,
 	}
 }
 
@@ -168,6 +202,26 @@ func (m *MetricsServer) IncClusterEventsCount(server string) {
 	m.clusterEventsCounter.WithLabelValues(server).Inc()
 }
 
+// IncWatchReconnect increments the number of watch reconnects of the specified kind
+func (m *MetricsServer) IncWatchReconnect(server string, gk schema.GroupKind) {
+	m.watchReconnectsCounter.WithLabelValues(server, gk.Group, gk.Kind).Inc()
+}
+
+// IncWatchStop increments the number of permanently stopped watches of the specified kind
+func (m *MetricsServer) IncWatchStop(server string, gk schema.GroupKind, reason string) {
+	m.watchStopsCounter.WithLabelValues(server, gk.Group, gk.Kind, reason).Inc()
+}
+
+// IncCacheOverLimit increments the number of times the number of cached resources of the cluster exceeded the limit
+func (m *MetricsServer) IncCacheOverLimit(server string) {
+	m.cacheOverLimitCounter.WithLabelValues(server).Inc()
+}
+
+// ObserveListDuration records the duration of the list request of the specified kind
+func (m *MetricsServer) ObserveListDuration(server string, gk schema.GroupKind, duration time.Duration) {
+	m.listDurationHistogram.WithLabelValues(server, gk.Group, gk.Kind).Observe(duration.Seconds())
+}
+
 // IncKubernetesRequest increments the kubernetes requests counter for an application
 func (m *MetricsServer) IncKubernetesRequest(app *argoappv1.Application, statusCode int) {
 	m.k8sRequestCounter.WithLabelValues(app.Namespace, app.Name, app.Spec.GetProject(), strconv.Itoa(statusCode)).Inc()
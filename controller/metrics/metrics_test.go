@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/cache"
 
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -235,3 +236,32 @@ func TestReconcileMetrics(t *testing.T) {
 	log.Println(body)
 	assertMetricsPrinted(t, appReconcileMetrics, body)
 }
+
+const clusterWatchMetrics = `argocd_cluster_watch_reconnects_total{group="apps",kind="Deployment",server="https://localhost:6443"} 2
+argocd_cluster_list_duration_seconds_sum{group="apps",kind="Deployment",server="https://localhost:6443"} 3
+argocd_cluster_list_duration_seconds_count{group="apps",kind="Deployment",server="https://localhost:6443"} 1
+argocd_cluster_watch_stops_total{group="apps",kind="Deployment",reason="NotFound",server="https://localhost:6443"} 1
+argocd_cluster_cache_over_limit_total{server="https://localhost:6443"} 1
+`
+
+func TestClusterWatchMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpHealthCheck)
+
+	gk := schema.GroupKind{Group: "apps", Kind: "Deployment"}
+	metricsServ.IncWatchReconnect("https://localhost:6443", gk)
+	metricsServ.IncWatchReconnect("https://localhost:6443", gk)
+	metricsServ.ObserveListDuration("https://localhost:6443", gk, 3*time.Second)
+	metricsServ.IncWatchStop("https://localhost:6443", gk, "NotFound")
+	metricsServ.IncCacheOverLimit("https://localhost:6443")
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	log.Println(body)
+	assertMetricsPrinted(t, clusterWatchMetrics, body)
+}
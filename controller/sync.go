@@ -281,7 +281,7 @@ func (sc *syncContext) sync() {
 	}
 
 	// syncFailTasks only run during failure, so separate them from regular tasks
-	syncFailTasks, tasks := tasks.Split(func(t *syncTask) bool { return t.phase == v1alpha1.SyncPhaseSyncFail })
+	syncFailTasks, tasks := tasks.Split(func(t *syncTask) bool { return t.phase.IsFailPhase() })
 
 	// if there are any completed but unsuccessful tasks, sync is a failure.
 	if tasks.Any(func(t *syncTask) bool { return t.completed() && !t.successful() }) {
@@ -656,10 +656,11 @@ func (sc *syncContext) getResourceIf(task *syncTask) (dynamic.ResourceInterface,
 }
 
 var operationPhases = map[v1alpha1.ResultCode]v1alpha1.OperationPhase{
-	v1alpha1.ResultCodeSynced:       v1alpha1.OperationRunning,
-	v1alpha1.ResultCodeSyncFailed:   v1alpha1.OperationFailed,
-	v1alpha1.ResultCodePruned:       v1alpha1.OperationSucceeded,
-	v1alpha1.ResultCodePruneSkipped: v1alpha1.OperationSucceeded,
+	v1alpha1.ResultCodeSynced:             v1alpha1.OperationRunning,
+	v1alpha1.ResultCodeSyncFailed:         v1alpha1.OperationFailed,
+	v1alpha1.ResultCodeSyncFailedConflict: v1alpha1.OperationFailed,
+	v1alpha1.ResultCodePruned:             v1alpha1.OperationSucceeded,
+	v1alpha1.ResultCodePruneSkipped:       v1alpha1.OperationSucceeded,
 }
 
 // tri-state
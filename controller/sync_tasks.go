@@ -6,14 +6,6 @@ import (
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 )
 
-// kindOrder represents the correct order of Kubernetes resources within a manifest
-var syncPhaseOrder = map[v1alpha1.SyncPhase]int{
-	v1alpha1.SyncPhasePreSync:  -1,
-	v1alpha1.SyncPhaseSync:     0,
-	v1alpha1.SyncPhasePostSync: 1,
-	v1alpha1.SyncPhaseSyncFail: 2,
-}
-
 // kindOrder represents the correct order of Kubernetes resources within a manifest
 // https://github.com/helm/helm/blob/master/pkg/tiller/kind_sorter.go
 var kindOrder = map[string]int{}
@@ -74,7 +66,7 @@ func (s syncTasks) Less(i, j int) bool {
 	tA := s[i]
 	tB := s[j]
 
-	d := syncPhaseOrder[tA.phase] - syncPhaseOrder[tB.phase]
+	d := tA.phase.Order() - tB.phase.Order()
 	if d != 0 {
 		return d < 0
 	}
@@ -2160,316 +2160,314 @@ func init() {
 }
 
 var fileDescriptor_e7dc23c2911a1a00 = []byte{
-	// 4929 bytes of a gzipped FileDescriptorProto
+	// 4912 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x5b, 0x8c, 0x1c, 0xd9,
-	0x55, 0x5b, 0xdd, 0x3d, 0x3d, 0xdd, 0x67, 0x1e, 0xf6, 0xdc, 0x5d, 0x6f, 0x3a, 0x66, 0xe3, 0xb1,
-	0xca, 0x4a, 0xb2, 0x21, 0x9b, 0x1e, 0xd6, 0x72, 0xc0, 0x01, 0x29, 0xcb, 0xf4, 0x8c, 0x1f, 0x63,
-	0xcf, 0x8c, 0x67, 0x6f, 0xcf, 0xae, 0xa5, 0x4d, 0x08, 0x5b, 0xae, 0xba, 0xdd, 0x5d, 0x9e, 0xee,
-	0xaa, 0xda, 0xaa, 0xea, 0xb1, 0x67, 0x21, 0x21, 0x01, 0x16, 0x85, 0x90, 0x45, 0x48, 0x88, 0x2f,
-	0x14, 0x5e, 0x7f, 0x44, 0xfc, 0xa0, 0x48, 0xc0, 0xf7, 0x7e, 0xc0, 0x7e, 0xa1, 0x10, 0x45, 0xb0,
-	0x02, 0x34, 0x62, 0x27, 0x7c, 0x20, 0xf8, 0x08, 0x08, 0xf1, 0xe3, 0x2f, 0x74, 0xdf, 0xb7, 0xaa,
-	0xbb, 0x3d, 0x63, 0x77, 0xd9, 0x41, 0xe1, 0xaf, 0xeb, 0x9c, 0x53, 0xe7, 0xdc, 0x7b, 0xee, 0xb9,
-	0xe7, 0x9e, 0x73, 0xee, 0xa9, 0x86, 0x8d, 0xae, 0x9f, 0xf6, 0x86, 0x77, 0x9a, 0x6e, 0x38, 0x58,
-	0x71, 0xe2, 0x6e, 0x18, 0xc5, 0xe1, 0x5d, 0xf6, 0xe3, 0x33, 0xae, 0xb7, 0x12, 0xed, 0x75, 0x57,
-	0x9c, 0xc8, 0x4f, 0x56, 0x9c, 0x28, 0xea, 0xfb, 0xae, 0x93, 0xfa, 0x61, 0xb0, 0xb2, 0xff, 0xb2,
-	0xd3, 0x8f, 0x7a, 0xce, 0xcb, 0x2b, 0x5d, 0x12, 0x90, 0xd8, 0x49, 0x89, 0xd7, 0x8c, 0xe2, 0x30,
-	0x0d, 0xd1, 0xe7, 0x34, 0xab, 0xa6, 0x64, 0xc5, 0x7e, 0xfc, 0xa2, 0xeb, 0x35, 0xa3, 0xbd, 0x6e,
-	0x93, 0xb2, 0x6a, 0x1a, 0xac, 0x9a, 0x92, 0xd5, 0xd9, 0xcf, 0x18, 0xa3, 0xe8, 0x86, 0xdd, 0x70,
-	0x85, 0x71, 0xbc, 0x33, 0xec, 0xb0, 0x27, 0xf6, 0xc0, 0x7e, 0x71, 0x49, 0x67, 0xed, 0xbd, 0xcb,
-	0x49, 0xd3, 0x0f, 0xe9, 0xd8, 0x56, 0xdc, 0x30, 0x26, 0x2b, 0xfb, 0x23, 0xa3, 0x39, 0x7b, 0x49,
-	0xd3, 0x0c, 0x1c, 0xb7, 0xe7, 0x07, 0x24, 0x3e, 0xd0, 0x13, 0x1a, 0x90, 0xd4, 0x19, 0xf7, 0xd6,
-	0xca, 0xa4, 0xb7, 0xe2, 0x61, 0x90, 0xfa, 0x03, 0x32, 0xf2, 0xc2, 0x4f, 0x1f, 0xf7, 0x42, 0xe2,
-	0xf6, 0xc8, 0xc0, 0xc9, 0xbf, 0x67, 0xbf, 0x05, 0x0b, 0xab, 0xb7, 0xdb, 0xab, 0xc3, 0xb4, 0xb7,
-	0x16, 0x06, 0x1d, 0xbf, 0x8b, 0x3e, 0x0b, 0x73, 0x6e, 0x7f, 0x98, 0xa4, 0x24, 0xde, 0x76, 0x06,
-	0xa4, 0x61, 0x9d, 0xb7, 0x5e, 0xac, 0xb7, 0x9e, 0x7d, 0xff, 0x70, 0xf9, 0x99, 0xa3, 0xc3, 0xe5,
-	0xb9, 0x35, 0x8d, 0xc2, 0x26, 0x1d, 0xfa, 0x14, 0xcc, 0xc6, 0x61, 0x9f, 0xac, 0xe2, 0xed, 0x46,
-	0x89, 0xbd, 0x72, 0x4a, 0xbc, 0x32, 0x8b, 0x39, 0x18, 0x4b, 0xbc, 0xfd, 0x4f, 0x16, 0xc0, 0x6a,
-	0x14, 0xed, 0xc4, 0xe1, 0x5d, 0xe2, 0xa6, 0xe8, 0x4d, 0xa8, 0x51, 0x2d, 0x78, 0x4e, 0xea, 0x30,
-	0x69, 0x73, 0x17, 0x7f, 0xaa, 0xc9, 0x27, 0xd3, 0x34, 0x27, 0xa3, 0x57, 0x8e, 0x52, 0x37, 0xf7,
-	0x5f, 0x6e, 0xde, 0xba, 0x43, 0xdf, 0xdf, 0x22, 0xa9, 0xd3, 0x42, 0x42, 0x18, 0x68, 0x18, 0x56,
-	0x5c, 0xd1, 0x1e, 0x54, 0x92, 0x88, 0xb8, 0x6c, 0x60, 0x73, 0x17, 0x37, 0x9a, 0x8f, 0x6d, 0x1f,
-	0x4d, 0x3d, 0xec, 0x76, 0x44, 0xdc, 0xd6, 0xbc, 0x10, 0x5b, 0xa1, 0x4f, 0x98, 0x09, 0xb1, 0xff,
-	0xd1, 0x82, 0x45, 0x4d, 0xb6, 0xe9, 0x27, 0x29, 0xfa, 0xe2, 0xc8, 0x0c, 0x9b, 0x27, 0x9b, 0x21,
-	0x7d, 0x9b, 0xcd, 0xef, 0xb4, 0x10, 0x54, 0x93, 0x10, 0x63, 0x76, 0x77, 0x61, 0xc6, 0x4f, 0xc9,
-	0x20, 0x69, 0x94, 0xce, 0x97, 0x5f, 0x9c, 0xbb, 0x78, 0xa5, 0x90, 0xe9, 0xb5, 0x16, 0x84, 0xc4,
-	0x99, 0x0d, 0xca, 0x1b, 0x73, 0x11, 0xf6, 0x5f, 0xcd, 0x9a, 0x93, 0xa3, 0xb3, 0x46, 0x2f, 0xc3,
-	0x5c, 0x12, 0x0e, 0x63, 0x97, 0x60, 0x12, 0x85, 0x49, 0xc3, 0x3a, 0x5f, 0xa6, 0x8b, 0x4f, 0x6d,
-	0xa5, 0xad, 0xc1, 0xd8, 0xa4, 0x41, 0xbf, 0x65, 0xc1, 0xbc, 0x47, 0x92, 0xd4, 0x0f, 0x98, 0x7c,
-	0x39, 0xf2, 0x57, 0xa7, 0x1b, 0xb9, 0x04, 0xae, 0x6b, 0xce, 0xad, 0xe7, 0xc4, 0x2c, 0xe6, 0x0d,
-	0x60, 0x82, 0x33, 0xc2, 0xa9, 0xc1, 0x7b, 0x24, 0x71, 0x63, 0x3f, 0xa2, 0xcf, 0x8d, 0x72, 0xd6,
-	0xe0, 0xd7, 0x35, 0x0a, 0x9b, 0x74, 0x68, 0x0f, 0x66, 0xa8, 0x41, 0x27, 0x8d, 0x0a, 0x1b, 0xfc,
-	0xd5, 0x29, 0x06, 0x2f, 0xd4, 0x49, 0x37, 0x8a, 0xd6, 0x3b, 0x7d, 0x4a, 0x30, 0x97, 0x81, 0xde,
-	0xb5, 0xa0, 0x21, 0x76, 0x1b, 0x26, 0x5c, 0x95, 0xb7, 0x7b, 0x7e, 0x4a, 0xfa, 0x7e, 0x92, 0x36,
-	0x66, 0xd8, 0x00, 0x56, 0x4e, 0x66, 0x52, 0xd7, 0xe2, 0x70, 0x18, 0xdd, 0xf4, 0x03, 0xaf, 0x75,
-	0x5e, 0x48, 0x6a, 0xac, 0x4d, 0x60, 0x8c, 0x27, 0x8a, 0x44, 0xbf, 0x6b, 0xc1, 0xd9, 0xc0, 0x19,
-	0x90, 0x24, 0x72, 0xe8, 0xa2, 0x72, 0x74, 0xab, 0xef, 0xb8, 0x7b, 0x6c, 0x44, 0xd5, 0xc7, 0x1b,
-	0x91, 0x2d, 0x46, 0x74, 0x76, 0x7b, 0x22, 0x6b, 0xfc, 0x10, 0xb1, 0xe8, 0x8f, 0x2c, 0x58, 0x0a,
-	0xe3, 0xa8, 0xe7, 0x04, 0xc4, 0x93, 0xd8, 0xa4, 0x31, 0xcb, 0x76, 0xdc, 0x17, 0xa6, 0x58, 0x9f,
-	0x5b, 0x79, 0x9e, 0x5b, 0x61, 0xe0, 0xa7, 0x61, 0xdc, 0x26, 0x69, 0xea, 0x07, 0xdd, 0xa4, 0x75,
-	0xe6, 0xe8, 0x70, 0x79, 0x69, 0x84, 0x0a, 0x8f, 0x0e, 0x06, 0xdd, 0x87, 0xb9, 0xe4, 0x20, 0x70,
-	0x6f, 0xfb, 0x81, 0x17, 0xde, 0x4b, 0x1a, 0xb5, 0xa9, 0xb7, 0x6c, 0x5b, 0x71, 0x13, 0x9b, 0x4e,
-	0x73, 0xc7, 0xa6, 0x28, 0xfb, 0xaf, 0xcb, 0x30, 0x67, 0xec, 0x92, 0xa7, 0xe0, 0x76, 0xfb, 0x19,
-	0xb7, 0x7b, 0xa3, 0x98, 0xdd, 0x3d, 0xc9, 0xef, 0xa2, 0x14, 0xaa, 0x49, 0xea, 0xa4, 0xc3, 0x84,
-	0xed, 0xe0, 0xb9, 0x8b, 0x9b, 0x05, 0xc9, 0x63, 0x3c, 0x5b, 0x8b, 0x42, 0x62, 0x95, 0x3f, 0x63,
-	0x21, 0x0b, 0xbd, 0x05, 0xf5, 0x30, 0xa2, 0x07, 0x2a, 0x75, 0x1d, 0x15, 0x26, 0x78, 0x7d, 0x1a,
-	0x4b, 0x93, 0xbc, 0x5a, 0x0b, 0x47, 0x87, 0xcb, 0x75, 0xf5, 0x88, 0xb5, 0x14, 0xfb, 0x1f, 0x2c,
-	0x78, 0xce, 0x18, 0xe0, 0x5a, 0x18, 0x78, 0x3e, 0x5b, 0xd1, 0xf3, 0x50, 0x49, 0x0f, 0x22, 0x79,
-	0x64, 0x2b, 0x1d, 0xed, 0x1e, 0x44, 0x04, 0x33, 0x0c, 0x3d, 0xa4, 0x07, 0x24, 0x49, 0x9c, 0x2e,
-	0xc9, 0x1f, 0xd2, 0x5b, 0x1c, 0x8c, 0x25, 0x1e, 0xc5, 0x80, 0xfa, 0x4e, 0x92, 0xee, 0xc6, 0x4e,
-	0x90, 0x30, 0xf6, 0xbb, 0xfe, 0x80, 0x08, 0xd5, 0xfe, 0xe4, 0xc9, 0x0c, 0x85, 0xbe, 0xd1, 0x7a,
-	0xfe, 0xe8, 0x70, 0x19, 0x6d, 0x8e, 0x70, 0xc2, 0x63, 0xb8, 0xdb, 0x6f, 0xc1, 0xf3, 0xe3, 0xfd,
-	0x38, 0xfa, 0x04, 0x54, 0x13, 0x12, 0xef, 0x93, 0x58, 0x4c, 0x4e, 0x2f, 0x07, 0x83, 0x62, 0x81,
-	0x45, 0x2b, 0x50, 0x57, 0xfe, 0x41, 0x4c, 0x71, 0x49, 0x90, 0xd6, 0xb5, 0x53, 0xd1, 0x34, 0xf6,
-	0x3f, 0x5b, 0x70, 0xca, 0x90, 0xf9, 0x14, 0x8e, 0xeb, 0xbd, 0xec, 0x71, 0x7d, 0xb5, 0x18, 0x33,
-	0x9d, 0x70, 0x5e, 0x7f, 0xa7, 0x0a, 0x4b, 0xa6, 0x31, 0x33, 0x2f, 0xc4, 0x62, 0x35, 0x12, 0x85,
-	0xaf, 0xe1, 0x4d, 0xa1, 0x4e, 0x1d, 0xab, 0x71, 0x30, 0x96, 0x78, 0x6a, 0x53, 0x91, 0x93, 0xf6,
-	0x84, 0x2e, 0x95, 0x4d, 0xed, 0x38, 0x69, 0x0f, 0x33, 0x0c, 0xfa, 0x3c, 0x2c, 0xa6, 0x4e, 0xdc,
-	0x25, 0x29, 0x26, 0xfb, 0x7e, 0x22, 0xb7, 0x41, 0xbd, 0xf5, 0xbc, 0xa0, 0x5d, 0xdc, 0xcd, 0x60,
-	0x71, 0x8e, 0x1a, 0x05, 0x50, 0xe9, 0x91, 0xfe, 0x40, 0xb8, 0xe9, 0x9d, 0x82, 0x76, 0x2d, 0x9b,
-	0xe8, 0x75, 0xd2, 0x1f, 0xb4, 0x6a, 0x74, 0xbc, 0xf4, 0x17, 0x66, 0x72, 0xd0, 0xaf, 0x5a, 0x50,
-	0xdf, 0x1b, 0x26, 0x69, 0x38, 0xf0, 0xdf, 0x26, 0x8d, 0x1a, 0x93, 0xfa, 0x5a, 0x91, 0x52, 0x6f,
-	0x4a, 0xe6, 0x7c, 0x0f, 0xab, 0x47, 0xac, 0xc5, 0xa2, 0xb7, 0x61, 0x76, 0x2f, 0x09, 0x83, 0x80,
-	0xa4, 0x8d, 0x3a, 0x1b, 0x41, 0xbb, 0xd0, 0x11, 0x70, 0xd6, 0xad, 0x39, 0xba, 0xa4, 0xe2, 0x01,
-	0x4b, 0x81, 0x4c, 0x01, 0x9e, 0x1f, 0x13, 0x37, 0x0d, 0xe3, 0x83, 0x06, 0x14, 0xaf, 0x80, 0x75,
-	0xc9, 0x9c, 0x2b, 0x40, 0x3d, 0x62, 0x2d, 0x16, 0xed, 0x43, 0x35, 0xea, 0x0f, 0xbb, 0x7e, 0xd0,
-	0x98, 0x63, 0x03, 0xc0, 0x45, 0x0e, 0x60, 0x87, 0x71, 0x6e, 0x01, 0x75, 0x10, 0xfc, 0x37, 0x16,
-	0xd2, 0xd0, 0x05, 0x98, 0x71, 0x7b, 0x4e, 0x9c, 0x36, 0xe6, 0x99, 0x91, 0xaa, 0x5d, 0xb3, 0x46,
-	0x81, 0x98, 0xe3, 0xec, 0xbf, 0xb1, 0xe0, 0xec, 0xe4, 0x59, 0xf1, 0xed, 0xe3, 0x0e, 0xe3, 0x84,
-	0xbb, 0xda, 0x9a, 0xb9, 0x7d, 0x18, 0x18, 0x4b, 0x3c, 0xfa, 0x0a, 0xcc, 0xde, 0x15, 0xeb, 0x5c,
-	0x2a, 0x7e, 0x9d, 0x6f, 0x88, 0x75, 0x56, 0xf2, 0x6f, 0xc8, 0xb5, 0x16, 0x42, 0xed, 0xef, 0x94,
-	0xe1, 0xcc, 0xd8, 0x6d, 0x81, 0x9a, 0x00, 0xfb, 0x4e, 0x7f, 0x48, 0xae, 0xfa, 0x34, 0x86, 0xe5,
-	0x51, 0xfb, 0x22, 0x3d, 0xca, 0x5f, 0x57, 0x50, 0x6c, 0x50, 0xa0, 0x5f, 0x06, 0x88, 0x9c, 0xd8,
-	0x19, 0x90, 0x94, 0xc4, 0xd2, 0x77, 0x5d, 0x9f, 0x62, 0x32, 0x74, 0x10, 0x3b, 0x92, 0xa1, 0x0e,
-	0x24, 0x14, 0x28, 0xc1, 0x86, 0x3c, 0x1a, 0xa3, 0xc7, 0xa4, 0x4f, 0x9c, 0x84, 0xb0, 0xa4, 0x34,
-	0x17, 0xa3, 0x63, 0x8d, 0xc2, 0x26, 0x1d, 0x3d, 0x36, 0xd8, 0x14, 0x12, 0xe1, 0x93, 0xd4, 0xb1,
-	0xc1, 0x26, 0x99, 0x60, 0x81, 0x45, 0xdf, 0xb4, 0x60, 0xb1, 0xe3, 0xf7, 0x89, 0x96, 0x2e, 0x82,
-	0xea, 0xcd, 0x29, 0x67, 0x78, 0xd5, 0x64, 0xaa, 0x5d, 0x62, 0x06, 0x9c, 0xe0, 0x9c, 0x6c, 0xfb,
-	0x7f, 0x2c, 0x68, 0x4c, 0x5a, 0x6c, 0x14, 0xc1, 0x2c, 0xb9, 0x9f, 0xbe, 0xee, 0xc4, 0x7c, 0xd5,
-	0xa6, 0x8b, 0x1e, 0x05, 0xd3, 0xd7, 0x9d, 0x58, 0x1b, 0xd1, 0x15, 0xce, 0x1d, 0x4b, 0x31, 0xa8,
-	0x0b, 0x95, 0xb4, 0xef, 0x14, 0x91, 0x5f, 0x1a, 0xe2, 0x74, 0x78, 0xb2, 0xb9, 0x9a, 0x60, 0x26,
-	0xc0, 0xfe, 0xde, 0xb8, 0x79, 0x0b, 0xff, 0x45, 0x4d, 0x80, 0x04, 0xfb, 0x7e, 0x1c, 0x06, 0x03,
-	0x12, 0xa4, 0xf9, 0xba, 0xc4, 0x15, 0x8d, 0xc2, 0x26, 0x1d, 0xfa, 0x95, 0x31, 0x76, 0x7b, 0x73,
-	0x8a, 0x29, 0x88, 0xe1, 0x9c, 0xd8, 0x74, 0xed, 0x3f, 0x2c, 0x8f, 0x71, 0x26, 0xea, 0x50, 0x40,
-	0x17, 0x01, 0x68, 0x34, 0xb2, 0x13, 0x93, 0x8e, 0x7f, 0x5f, 0xcc, 0x4a, 0xb1, 0xdc, 0x56, 0x18,
-	0x6c, 0x50, 0xc9, 0x77, 0xda, 0xc3, 0x0e, 0x7d, 0xa7, 0x34, 0xfa, 0x0e, 0xc7, 0x60, 0x83, 0x0a,
-	0x5d, 0x82, 0xaa, 0x3f, 0x70, 0xba, 0x84, 0x86, 0xc7, 0x74, 0xaf, 0xbf, 0x40, 0xb7, 0xc1, 0x06,
-	0x83, 0x3c, 0x38, 0x5c, 0x5e, 0x54, 0x03, 0x62, 0x20, 0x2c, 0x68, 0xd1, 0x1f, 0x5b, 0x30, 0xef,
-	0x86, 0x83, 0x41, 0x18, 0x6c, 0x3a, 0x77, 0x48, 0x5f, 0x26, 0xbb, 0xdd, 0x27, 0x72, 0x5e, 0x36,
-	0xd7, 0x0c, 0x49, 0x57, 0x82, 0x34, 0x3e, 0xd0, 0xf9, 0xbb, 0x89, 0xc2, 0x99, 0x21, 0x9d, 0x7d,
-	0x05, 0x96, 0x46, 0x5e, 0x44, 0xa7, 0xa1, 0xbc, 0x47, 0x0e, 0xb8, 0x3e, 0x31, 0xfd, 0x89, 0x9e,
-	0x83, 0x19, 0xb6, 0xdb, 0xb9, 0xbe, 0x30, 0x7f, 0xf8, 0xd9, 0xd2, 0x65, 0xcb, 0xfe, 0x7d, 0x0b,
-	0x3e, 0x32, 0xe1, 0x0c, 0xa1, 0xf1, 0x4f, 0xa0, 0xcb, 0x60, 0xca, 0x68, 0x99, 0xab, 0x61, 0x18,
-	0xf4, 0x25, 0x28, 0x93, 0x60, 0x5f, 0x58, 0xd6, 0xda, 0x14, 0x8a, 0xb9, 0x12, 0xec, 0xf3, 0x49,
-	0xcf, 0x1e, 0x1d, 0x2e, 0x97, 0xaf, 0x04, 0xfb, 0x98, 0x32, 0xb6, 0xdf, 0xa9, 0x66, 0x22, 0xd4,
-	0xb6, 0xcc, 0x75, 0xd8, 0x28, 0x45, 0x7c, 0xba, 0x59, 0xe4, 0x7a, 0x18, 0xc1, 0x35, 0xaf, 0xd9,
-	0x08, 0x59, 0xe8, 0xeb, 0x16, 0xab, 0x94, 0xc8, 0xa0, 0x5c, 0x9c, 0x68, 0x4f, 0xa0, 0x6a, 0x63,
-	0x16, 0x5f, 0x24, 0x10, 0x9b, 0xa2, 0xe9, 0x11, 0x1c, 0xf1, 0xa2, 0x89, 0x38, 0x0b, 0x94, 0xf7,
-	0x92, 0xb5, 0x14, 0x89, 0x47, 0x43, 0x00, 0x9a, 0x06, 0xef, 0x84, 0x7d, 0xdf, 0x3d, 0x10, 0x29,
-	0xda, 0xb4, 0x09, 0x37, 0x67, 0xc6, 0xcf, 0x4b, 0xfd, 0x8c, 0x0d, 0x41, 0xe8, 0x5b, 0x16, 0x2c,
-	0xf9, 0xdd, 0x20, 0x8c, 0xc9, 0xba, 0xdf, 0xe9, 0x90, 0x98, 0x04, 0x2e, 0x91, 0xa7, 0xca, 0xee,
-	0x14, 0xe2, 0x65, 0x29, 0x61, 0x23, 0xcf, 0xbb, 0xf5, 0x51, 0xa1, 0x82, 0xa5, 0x11, 0x14, 0x1e,
-	0x1d, 0x09, 0x72, 0xa0, 0xe2, 0x07, 0x9d, 0x50, 0x94, 0x6a, 0x5e, 0x99, 0x62, 0x44, 0x1b, 0x41,
-	0x27, 0xd4, 0x3b, 0x83, 0x3e, 0x61, 0xc6, 0x1a, 0x6d, 0xc2, 0x73, 0xb1, 0x88, 0xf2, 0xaf, 0xfb,
-	0x09, 0x0d, 0x9d, 0x36, 0xfd, 0x81, 0x9f, 0xb2, 0x48, 0xbf, 0xdc, 0x6a, 0x1c, 0x1d, 0x2e, 0x3f,
-	0x87, 0xc7, 0xe0, 0xf1, 0xd8, 0xb7, 0xec, 0xff, 0xae, 0x65, 0x53, 0x19, 0x9e, 0x7f, 0xbf, 0x0d,
-	0xf5, 0x58, 0x55, 0x7a, 0xf8, 0x79, 0xb8, 0x51, 0x80, 0x76, 0x45, 0xd6, 0xaf, 0x72, 0x47, 0x5d,
-	0xd3, 0xd1, 0xe2, 0xe8, 0xb9, 0x48, 0x17, 0x5c, 0xec, 0x83, 0x69, 0x6d, 0x4a, 0x88, 0xd4, 0xa5,
-	0x8d, 0x83, 0xc0, 0xc5, 0x4c, 0x00, 0x0a, 0xa1, 0xda, 0x23, 0x4e, 0x3f, 0xed, 0x89, 0xfc, 0xfb,
-	0xda, 0x54, 0x51, 0x09, 0x65, 0x94, 0xaf, 0x6a, 0x70, 0x28, 0x16, 0x62, 0xd0, 0x10, 0x66, 0x7b,
-	0x5c, 0xf7, 0xc2, 0xe1, 0xdf, 0x98, 0x4a, 0xa7, 0x99, 0xd5, 0xd4, 0x5b, 0x55, 0x00, 0xb0, 0x94,
-	0x85, 0x7e, 0xcd, 0x02, 0x70, 0x65, 0x39, 0x43, 0x6e, 0x96, 0x5b, 0xc5, 0xf8, 0x17, 0x55, 0x26,
-	0xd1, 0x27, 0xa5, 0x02, 0x25, 0xd8, 0x10, 0x8b, 0xde, 0x84, 0xf9, 0x98, 0xb8, 0x61, 0xe0, 0xfa,
-	0x7d, 0xe2, 0xad, 0xa6, 0x8d, 0xea, 0x23, 0xd7, 0x3c, 0x4e, 0xd3, 0x13, 0x0b, 0x1b, 0x3c, 0x70,
-	0x86, 0x23, 0x7a, 0xc7, 0x82, 0x45, 0x55, 0xcf, 0xa1, 0x4b, 0x41, 0x44, 0xf6, 0xbb, 0x51, 0x44,
-	0xe9, 0x88, 0x31, 0x6c, 0x21, 0x1a, 0x67, 0x66, 0x61, 0x38, 0x27, 0x14, 0xbd, 0x01, 0x10, 0xde,
-	0x61, 0x95, 0x13, 0x3a, 0xcf, 0xda, 0x23, 0xcf, 0x73, 0x91, 0x97, 0xfe, 0x24, 0x07, 0x6c, 0x70,
-	0x43, 0x37, 0x01, 0xf8, 0x3e, 0xd9, 0x3d, 0x88, 0x08, 0x4b, 0x72, 0xeb, 0xad, 0x4f, 0x4b, 0xcd,
-	0xb7, 0x15, 0xe6, 0xc1, 0xe1, 0xf2, 0x68, 0x82, 0xc2, 0x2a, 0x56, 0xc6, 0xeb, 0xe8, 0x3e, 0xcc,
-	0x26, 0xc3, 0xc1, 0xc0, 0x51, 0xf9, 0xea, 0x56, 0x41, 0x07, 0x1e, 0x67, 0xaa, 0x4d, 0x52, 0x00,
-	0xb0, 0x14, 0x67, 0x07, 0x80, 0x46, 0xe9, 0xd1, 0x25, 0x98, 0x27, 0xf7, 0x53, 0x12, 0x07, 0x4e,
-	0xff, 0x35, 0xbc, 0x29, 0xd3, 0x27, 0xb6, 0xec, 0x57, 0x0c, 0x38, 0xce, 0x50, 0x21, 0x5b, 0x85,
-	0x60, 0x25, 0x46, 0x0f, 0x3a, 0x04, 0x93, 0x01, 0x97, 0xfd, 0x1b, 0xa5, 0xcc, 0x69, 0xbf, 0x1b,
-	0x13, 0x82, 0xfa, 0x30, 0x13, 0x84, 0x9e, 0xf2, 0x6f, 0xd7, 0x0a, 0xf0, 0x6f, 0xdb, 0xa1, 0x67,
-	0x5c, 0x35, 0xd0, 0xa7, 0x04, 0x73, 0x21, 0xe8, 0xd7, 0x2d, 0x58, 0x90, 0x75, 0x6b, 0x86, 0x10,
-	0xa1, 0x4d, 0x61, 0x62, 0xcf, 0x08, 0xb1, 0x0b, 0xb7, 0x4c, 0x29, 0x38, 0x2b, 0xd4, 0xfe, 0x81,
-	0x95, 0xc9, 0x5c, 0x6f, 0x3b, 0xa9, 0xdb, 0xbb, 0xb2, 0x4f, 0x23, 0xfa, 0x9b, 0x99, 0x32, 0xe7,
-	0xcf, 0x98, 0x65, 0xce, 0x07, 0x87, 0xcb, 0x9f, 0x9c, 0x74, 0x0f, 0x7a, 0x8f, 0x72, 0x68, 0x32,
-	0x16, 0x46, 0x45, 0xf4, 0xcb, 0x30, 0x67, 0x8c, 0x58, 0xb8, 0xf2, 0xa2, 0x6a, 0x72, 0x2a, 0x8e,
-	0x31, 0x80, 0xd8, 0x94, 0x67, 0xbf, 0x57, 0x86, 0x59, 0x71, 0xfd, 0x72, 0xe2, 0x1a, 0xa7, 0x0c,
-	0x49, 0x4b, 0x13, 0x43, 0xd2, 0x08, 0xaa, 0x2e, 0xbb, 0xcc, 0x15, 0xe7, 0xc5, 0x34, 0x79, 0xba,
-	0x18, 0x1d, 0xbf, 0x1c, 0xd6, 0x63, 0xe2, 0xcf, 0x58, 0xc8, 0x41, 0xef, 0x5a, 0x70, 0xca, 0xa5,
-	0x89, 0x91, 0xab, 0x5d, 0x5a, 0x65, 0xea, 0xb2, 0xff, 0x5a, 0x96, 0x63, 0xeb, 0x23, 0x42, 0xfa,
-	0xa9, 0x1c, 0x02, 0xe7, 0x65, 0xa3, 0x9f, 0x83, 0x05, 0xae, 0xad, 0xd7, 0x49, 0xcc, 0x6a, 0x92,
-	0x33, 0x4c, 0x59, 0xca, 0xf4, 0xda, 0x26, 0x12, 0x67, 0x69, 0x51, 0x93, 0xa7, 0x57, 0xac, 0x40,
-	0x9c, 0xb0, 0x00, 0x49, 0x94, 0x46, 0x54, 0x05, 0x39, 0xc1, 0x06, 0x85, 0xfd, 0x17, 0x65, 0x58,
-	0xc8, 0xa8, 0x09, 0xbd, 0x04, 0xb5, 0x61, 0x42, 0x37, 0xbe, 0xca, 0x1c, 0x54, 0x45, 0xf8, 0x35,
-	0x01, 0xc7, 0x8a, 0x82, 0x52, 0x47, 0x4e, 0x92, 0xdc, 0x0b, 0x63, 0x4f, 0x2c, 0xaa, 0xa2, 0xde,
-	0x11, 0x70, 0xac, 0x28, 0x68, 0x1e, 0x7c, 0x87, 0x38, 0x31, 0x89, 0x77, 0xc3, 0x3d, 0x32, 0x72,
-	0x5d, 0xd9, 0xd2, 0x28, 0x6c, 0xd2, 0xb1, 0x15, 0x4a, 0xfb, 0xc9, 0x5a, 0xdf, 0x27, 0x41, 0xca,
-	0x87, 0x59, 0xc0, 0x0a, 0xed, 0x6e, 0xb6, 0x4d, 0x8e, 0x7a, 0x85, 0x72, 0x08, 0x9c, 0x97, 0x8d,
-	0xbe, 0x66, 0xc1, 0x82, 0x73, 0x2f, 0xd1, 0x8d, 0x07, 0x6c, 0x89, 0xa6, 0xb3, 0xd5, 0x4c, 0x23,
-	0x43, 0x6b, 0x89, 0x2e, 0x74, 0x06, 0x84, 0xb3, 0x12, 0xed, 0xef, 0x5b, 0x20, 0x1b, 0x1a, 0x9e,
-	0x42, 0xe1, 0xbf, 0x9b, 0x2d, 0xfc, 0xb7, 0xa6, 0xdf, 0x94, 0x13, 0x8a, 0xfe, 0xdb, 0x30, 0x4b,
-	0x13, 0x62, 0x27, 0xf0, 0xd0, 0xc7, 0x61, 0xd6, 0xe5, 0x3f, 0xc5, 0x19, 0xc5, 0x4a, 0xc2, 0x02,
-	0x8b, 0x25, 0x0e, 0xbd, 0x00, 0x15, 0x27, 0xee, 0xca, 0x73, 0x89, 0x55, 0xcc, 0x57, 0xe3, 0x6e,
-	0x82, 0x19, 0xd4, 0x7e, 0xb7, 0x04, 0xb0, 0x16, 0x0e, 0x22, 0x27, 0x26, 0xde, 0x6e, 0xf8, 0xff,
-	0x3e, 0xf9, 0xb4, 0xbf, 0x69, 0x01, 0xa2, 0xfa, 0x08, 0x03, 0x12, 0xe8, 0x42, 0x10, 0x5a, 0x81,
-	0xba, 0x2b, 0xa1, 0x62, 0xd7, 0xab, 0xfc, 0x41, 0x91, 0x63, 0x4d, 0x73, 0x02, 0x47, 0x7e, 0x41,
-	0xd6, 0x2c, 0xca, 0xd9, 0x6a, 0x35, 0x2b, 0x5f, 0x8a, 0x12, 0x86, 0xfd, 0xdb, 0x25, 0x78, 0x9e,
-	0x1b, 0xf4, 0x96, 0x13, 0x38, 0x5d, 0x32, 0xa0, 0xa3, 0x3a, 0x69, 0xf5, 0xe2, 0x4d, 0x9a, 0x06,
-	0xfa, 0xb2, 0x3a, 0x3d, 0x95, 0x4d, 0x72, 0x5b, 0xe2, 0xd6, 0xb3, 0x11, 0xf8, 0x29, 0x66, 0x9c,
-	0x51, 0x04, 0x35, 0xd9, 0x73, 0x24, 0x8e, 0xa3, 0x22, 0xa4, 0xa8, 0x8d, 0x76, 0x4d, 0xf0, 0xc6,
-	0x4a, 0x8a, 0xfd, 0x9e, 0x05, 0xf9, 0x13, 0x82, 0x1d, 0xae, 0xfc, 0x76, 0x38, 0x7f, 0xb8, 0x66,
-	0xef, 0x73, 0x1f, 0xe1, 0x86, 0xf4, 0x8b, 0x30, 0xe7, 0xa4, 0x29, 0x19, 0x44, 0x29, 0x0b, 0x9f,
-	0xcb, 0x8f, 0x17, 0x3e, 0x6f, 0x85, 0x9e, 0xdf, 0xf1, 0x59, 0xf8, 0x6c, 0xb2, 0xb3, 0x5f, 0x85,
-	0x9a, 0x2c, 0x08, 0x9d, 0x60, 0x19, 0x2f, 0x64, 0x8a, 0x5b, 0x13, 0x0c, 0xc5, 0x81, 0x79, 0x33,
-	0xfb, 0x7b, 0x02, 0x3a, 0xb1, 0x6f, 0xc3, 0xd2, 0x48, 0xd9, 0xfb, 0x04, 0xc3, 0x3f, 0xf6, 0x96,
-	0xd1, 0x7e, 0xd7, 0x82, 0x85, 0xcc, 0x95, 0x41, 0x41, 0x4a, 0xa1, 0xc7, 0x69, 0x27, 0x64, 0x19,
-	0x7f, 0xec, 0x07, 0x3c, 0x60, 0xaa, 0x69, 0x1f, 0x70, 0x55, 0xa3, 0xb0, 0x49, 0x67, 0x6f, 0x01,
-	0xab, 0x74, 0x14, 0xb5, 0x34, 0xaf, 0x42, 0x8d, 0xb2, 0xa3, 0x6e, 0xbc, 0x28, 0x96, 0x6d, 0xa8,
-	0xdd, 0xb8, 0xbd, 0xcb, 0x0f, 0x7f, 0x1b, 0xca, 0xbe, 0xc3, 0x9d, 0x52, 0x59, 0x6f, 0x9d, 0x8d,
-	0x24, 0x19, 0x32, 0xc3, 0xa3, 0x48, 0x74, 0x01, 0xca, 0xe4, 0x7e, 0xc4, 0x58, 0x96, 0xb5, 0xe3,
-	0xba, 0x72, 0x3f, 0xf2, 0x63, 0x92, 0x50, 0x22, 0x72, 0x3f, 0xb2, 0x87, 0x00, 0xba, 0x86, 0x5f,
-	0xd4, 0x12, 0x9c, 0x87, 0x8a, 0x1b, 0x7a, 0x44, 0xe8, 0x5e, 0xb1, 0x59, 0x0b, 0x3d, 0x82, 0x19,
-	0xc6, 0xfe, 0x86, 0x05, 0xa7, 0xf3, 0x85, 0xf7, 0x1f, 0x99, 0xbf, 0xdd, 0x84, 0xd3, 0xaa, 0x64,
-	0x7d, 0x2b, 0xe2, 0x35, 0x83, 0xcb, 0x30, 0x7f, 0x67, 0xe8, 0xf7, 0x3d, 0xf1, 0x2c, 0x86, 0xa3,
-	0xaa, 0xd7, 0x2d, 0x03, 0x87, 0x33, 0x94, 0x76, 0x02, 0xba, 0xcb, 0x03, 0x75, 0x44, 0x45, 0xc9,
-	0x9a, 0x3a, 0x14, 0x6a, 0x1f, 0x04, 0xae, 0x6e, 0x26, 0xa9, 0x65, 0x0b, 0x4a, 0xf6, 0x9f, 0x54,
-	0x20, 0x57, 0x1b, 0x40, 0x43, 0xb3, 0x91, 0xc5, 0x2a, 0xb0, 0x91, 0x45, 0xad, 0xc9, 0xb8, 0x66,
-	0x16, 0xf4, 0x59, 0x98, 0x89, 0x7a, 0x4e, 0x22, 0x17, 0x65, 0x59, 0x6a, 0x7c, 0x87, 0x02, 0x1f,
-	0x98, 0x25, 0x0c, 0x06, 0xc1, 0x9c, 0xda, 0x74, 0x49, 0xe5, 0x63, 0xdc, 0xf4, 0x57, 0x78, 0xfd,
-	0x17, 0x93, 0x64, 0xd8, 0x4f, 0x45, 0xc8, 0xbb, 0x5d, 0x94, 0x66, 0x39, 0x57, 0x5d, 0x08, 0xe6,
-	0xcf, 0xd8, 0x90, 0x88, 0xbe, 0x00, 0xf5, 0x24, 0x75, 0xe2, 0xf4, 0x31, 0x6b, 0x49, 0x4a, 0x7d,
-	0x6d, 0xc9, 0x04, 0x6b, 0x7e, 0xe8, 0x0d, 0x80, 0x8e, 0x1f, 0xf8, 0x49, 0x8f, 0x71, 0x9f, 0x7d,
-	0xbc, 0x23, 0xe8, 0xaa, 0xe2, 0x80, 0x0d, 0x6e, 0xf6, 0xcf, 0xc3, 0xf9, 0xe3, 0x1a, 0xdf, 0x68,
-	0xe0, 0x78, 0xcf, 0x89, 0x03, 0x71, 0x0f, 0xce, 0xcc, 0xec, 0xb6, 0x13, 0x07, 0x98, 0x41, 0xed,
-	0x6f, 0x97, 0x60, 0xce, 0xe8, 0x6d, 0x3c, 0x81, 0xbf, 0xc8, 0xf5, 0x62, 0x96, 0x4e, 0xd8, 0x8b,
-	0xf9, 0x22, 0xd4, 0xa2, 0xb0, 0xef, 0xbb, 0xbe, 0xba, 0xde, 0x9a, 0x67, 0xd9, 0x93, 0x80, 0x61,
-	0x85, 0x45, 0x29, 0xd4, 0xef, 0xde, 0x4b, 0x99, 0x57, 0x94, 0x97, 0x59, 0xd3, 0xdc, 0xd9, 0x48,
-	0x0f, 0xab, 0x97, 0x49, 0x42, 0x12, 0xac, 0x05, 0x21, 0x1b, 0xaa, 0xdd, 0x38, 0x1c, 0x46, 0xbc,
-	0xa6, 0x29, 0x2a, 0x3f, 0xac, 0xef, 0x31, 0xc1, 0x02, 0x63, 0x7f, 0xaf, 0x04, 0x75, 0x4c, 0xa2,
-	0x70, 0x2d, 0x26, 0x5e, 0x82, 0x3e, 0x06, 0xe5, 0x61, 0xdc, 0x17, 0x9a, 0x9a, 0x13, 0xcc, 0xcb,
-	0xaf, 0xe1, 0x4d, 0x4c, 0xe1, 0x99, 0x04, 0xb3, 0xf4, 0x48, 0x09, 0x66, 0xf9, 0xd8, 0x04, 0x93,
-	0xe6, 0xce, 0x49, 0x6f, 0x27, 0xf6, 0xf7, 0x9d, 0x94, 0xdc, 0x24, 0x07, 0xe2, 0xee, 0x5c, 0xe7,
-	0xce, 0xed, 0xeb, 0x1a, 0x89, 0xb3, 0xb4, 0xe8, 0x1a, 0x2c, 0xe9, 0x4c, 0x8f, 0xc4, 0xe9, 0x3a,
-	0xcd, 0xa5, 0x78, 0xf2, 0xad, 0xee, 0x27, 0x74, 0x6e, 0x28, 0x08, 0xf0, 0xe8, 0x3b, 0x68, 0x1d,
-	0x4e, 0x67, 0x80, 0x74, 0x20, 0x55, 0xc6, 0xa7, 0x21, 0xf8, 0x9c, 0xce, 0xf0, 0xa1, 0x63, 0x19,
-	0x79, 0xc3, 0xfe, 0xc0, 0x82, 0x05, 0xa5, 0xd4, 0xa7, 0x90, 0xe3, 0xf9, 0xd9, 0x1c, 0x6f, 0x7d,
-	0xaa, 0x9a, 0x99, 0x18, 0xf6, 0x84, 0x2c, 0xef, 0x0f, 0xaa, 0x00, 0xac, 0x9d, 0xda, 0x67, 0xb5,
-	0xf3, 0xf3, 0x50, 0x89, 0x49, 0x14, 0xe6, 0xf7, 0x16, 0xa5, 0xc0, 0x0c, 0xf3, 0x7f, 0xd7, 0x66,
-	0xc6, 0x15, 0x8f, 0x66, 0x7e, 0x84, 0xc5, 0xa3, 0x36, 0x9c, 0xf1, 0x83, 0x84, 0xb8, 0xc3, 0x58,
-	0xdc, 0xb2, 0x5d, 0x0f, 0x13, 0x65, 0x7f, 0xb5, 0xd6, 0xc7, 0x04, 0xa3, 0x33, 0x1b, 0xe3, 0x88,
-	0xf0, 0xf8, 0x77, 0xa9, 0x3e, 0x25, 0x82, 0xf9, 0xe9, 0x9a, 0x11, 0x87, 0x09, 0x38, 0x56, 0x14,
-	0x34, 0xb6, 0x21, 0x81, 0x73, 0xa7, 0x4f, 0x36, 0x3b, 0x09, 0x2b, 0xcc, 0xd7, 0x8c, 0x90, 0x8c,
-	0x23, 0xae, 0xb6, 0xb1, 0xa6, 0x19, 0xbf, 0xef, 0xea, 0x05, 0xed, 0x3b, 0x78, 0xd4, 0x7d, 0xa7,
-	0x5a, 0x51, 0xe7, 0x26, 0xb6, 0xa2, 0xca, 0xb3, 0x60, 0x7e, 0xe2, 0x59, 0xf0, 0x79, 0x58, 0xf4,
-	0x83, 0x1e, 0x89, 0xfd, 0x94, 0x78, 0x6c, 0x23, 0x34, 0x16, 0x98, 0x22, 0x54, 0x17, 0xcd, 0x46,
-	0x06, 0x8b, 0x73, 0xd4, 0xf6, 0xd7, 0x4b, 0x70, 0x46, 0x6f, 0x10, 0x3a, 0x32, 0xbf, 0x43, 0xad,
-	0x84, 0xf5, 0x5c, 0xf0, 0x8a, 0x9f, 0xf1, 0x85, 0x8b, 0xba, 0x15, 0x6a, 0x2b, 0x0c, 0x36, 0xa8,
-	0xe8, 0xfa, 0xb9, 0x24, 0x66, 0xa5, 0xe3, 0xfc, 0xee, 0x59, 0x13, 0x70, 0xac, 0x28, 0xd8, 0x47,
-	0x34, 0x24, 0x4e, 0xdb, 0xc3, 0x3b, 0xec, 0x85, 0x5c, 0x91, 0x6e, 0x4d, 0xa3, 0xb0, 0x49, 0x47,
-	0xcf, 0x31, 0x57, 0x2e, 0x1e, 0xdd, 0x41, 0xf3, 0xfc, 0x1c, 0x53, 0xeb, 0xa5, 0xb0, 0x72, 0x38,
-	0x34, 0x69, 0x10, 0xee, 0x35, 0x33, 0x1c, 0x76, 0x0b, 0xab, 0x28, 0xec, 0xff, 0xb4, 0xe0, 0xa3,
-	0x63, 0x55, 0xf1, 0x14, 0x5c, 0xe2, 0x30, 0xeb, 0x12, 0x77, 0xa6, 0x74, 0x89, 0x23, 0x53, 0x98,
-	0xe0, 0x1e, 0xff, 0xde, 0x82, 0x45, 0x4d, 0xff, 0x14, 0xe6, 0xd9, 0x29, 0xee, 0x33, 0x1c, 0x3d,
-	0xee, 0x56, 0x7d, 0x64, 0x62, 0x1f, 0xb0, 0x89, 0xf1, 0x78, 0x6c, 0xd5, 0x95, 0x8d, 0xdf, 0xc7,
-	0xc4, 0x55, 0xfb, 0x50, 0x65, 0x2d, 0x49, 0x72, 0x74, 0xdb, 0x05, 0x5c, 0xe6, 0x70, 0xe1, 0x2c,
-	0x1f, 0xd3, 0xa5, 0x03, 0xf6, 0x98, 0x60, 0x21, 0x8d, 0x9a, 0xa9, 0xe7, 0x27, 0xd4, 0x49, 0x79,
-	0x22, 0xbd, 0x53, 0x2a, 0x5c, 0x17, 0x70, 0xac, 0x28, 0xec, 0x01, 0x34, 0xb2, 0xcc, 0xd7, 0x09,
-	0x8d, 0x47, 0x4f, 0x38, 0xc7, 0x15, 0xa8, 0x3b, 0xec, 0xad, 0xcd, 0xa1, 0x93, 0xef, 0xfd, 0x5e,
-	0x95, 0x08, 0xac, 0x69, 0xec, 0x3f, 0xb5, 0xe0, 0xd9, 0x31, 0x93, 0x29, 0x30, 0xad, 0x4d, 0xf5,
-	0xe6, 0x9f, 0xd0, 0x8e, 0xef, 0x91, 0x8e, 0x23, 0xf3, 0x12, 0x23, 0x8b, 0x59, 0xe7, 0x60, 0x2c,
-	0xf1, 0xf6, 0xbf, 0x5b, 0x70, 0x2a, 0x3b, 0xd6, 0x04, 0xdd, 0x00, 0xc4, 0x27, 0xb3, 0xee, 0x27,
-	0x6e, 0xb8, 0x4f, 0xe2, 0x03, 0x3a, 0x73, 0x3e, 0xea, 0xb3, 0x82, 0x13, 0x5a, 0x1d, 0xa1, 0xc0,
-	0x63, 0xde, 0x42, 0xdf, 0x60, 0xe5, 0x55, 0xa9, 0x6d, 0x69, 0x26, 0xed, 0xc2, 0xcc, 0x44, 0xaf,
-	0xa4, 0x19, 0xce, 0x2b, 0x79, 0xd8, 0x14, 0x6e, 0xff, 0xb0, 0x0c, 0xf3, 0xf2, 0xf5, 0x75, 0xbf,
-	0xd3, 0xa1, 0xfa, 0x66, 0x51, 0xb2, 0x98, 0x9c, 0xd2, 0x37, 0x0b, 0xa1, 0x31, 0xc7, 0x51, 0x7d,
-	0xef, 0xf9, 0x81, 0x97, 0x4f, 0xef, 0x6f, 0xfa, 0x81, 0x87, 0x19, 0x26, 0xfb, 0x75, 0x40, 0xf9,
-	0xf8, 0xaf, 0x03, 0x94, 0x25, 0x54, 0x1e, 0x96, 0xb0, 0xf0, 0x7e, 0x76, 0x1d, 0xb6, 0x18, 0x8e,
-	0x7e, 0x57, 0xa3, 0xb0, 0x49, 0x47, 0x47, 0xd2, 0xf7, 0xf7, 0x09, 0x7f, 0xa9, 0x9a, 0x1d, 0xc9,
-	0xa6, 0x44, 0x60, 0x4d, 0x43, 0x47, 0xe2, 0xf9, 0x9d, 0x0e, 0x0b, 0x1d, 0x8c, 0x91, 0x50, 0xed,
-	0x60, 0x86, 0xa1, 0x14, 0xbd, 0x30, 0xdc, 0x13, 0xd1, 0x82, 0xa2, 0xb8, 0x1e, 0x86, 0x7b, 0x98,
-	0x61, 0xd0, 0x16, 0x3c, 0x1b, 0x84, 0xf1, 0xc0, 0xe9, 0xfb, 0x6f, 0x13, 0x4f, 0x49, 0x11, 0x51,
-	0xc2, 0x4f, 0x88, 0x17, 0x9e, 0xdd, 0x1e, 0x25, 0xc1, 0xe3, 0xde, 0xa3, 0xe6, 0x17, 0xc5, 0xc4,
-	0xf3, 0xdd, 0xd4, 0xe4, 0x06, 0x59, 0xf3, 0xdb, 0x19, 0xa1, 0xc0, 0x63, 0xde, 0xb2, 0xff, 0x83,
-	0x1d, 0x50, 0x13, 0x3a, 0x9b, 0x8a, 0x5a, 0x7e, 0xb9, 0x9a, 0xe5, 0x87, 0xb9, 0x10, 0x6d, 0x20,
-	0x95, 0x13, 0x18, 0xc8, 0x25, 0x98, 0xbf, 0x9b, 0x84, 0xc1, 0x4e, 0xe8, 0x07, 0xaa, 0x6b, 0x58,
-	0x34, 0x02, 0xdc, 0x68, 0xdf, 0xda, 0x96, 0x70, 0x9c, 0xa1, 0xb2, 0xdf, 0x9b, 0x81, 0xe7, 0xd5,
-	0x95, 0x38, 0x49, 0xef, 0x85, 0xf1, 0x9e, 0x1f, 0x74, 0x59, 0x3d, 0xf1, 0x5b, 0x16, 0xcc, 0x73,
-	0x43, 0x11, 0x0d, 0x97, 0xfc, 0xce, 0xdf, 0x2d, 0xe2, 0xf2, 0x3d, 0x23, 0xa9, 0xb9, 0x6b, 0x48,
-	0xc9, 0x35, 0x5b, 0x9a, 0x28, 0x9c, 0x19, 0x0e, 0x7a, 0x1b, 0x40, 0x7e, 0xbf, 0xd1, 0x29, 0xe2,
-	0x13, 0x16, 0x39, 0x38, 0x4c, 0x3a, 0x3a, 0x04, 0xdb, 0x55, 0x12, 0xb0, 0x21, 0x0d, 0xbd, 0x63,
-	0x41, 0xb5, 0xcf, 0xb5, 0x52, 0x66, 0x82, 0x7f, 0xa1, 0x78, 0xad, 0x98, 0xfa, 0x50, 0x87, 0x9a,
-	0xd0, 0x84, 0x10, 0x8e, 0x30, 0xcc, 0xfa, 0x41, 0x37, 0x26, 0x89, 0xac, 0x20, 0x7c, 0xd2, 0x08,
-	0x23, 0x9a, 0x6e, 0x18, 0x13, 0x16, 0x34, 0x84, 0x8e, 0xd7, 0x72, 0xfa, 0x4e, 0xe0, 0x92, 0x78,
-	0x83, 0x93, 0x6b, 0xff, 0x2e, 0x00, 0x58, 0x32, 0x1a, 0xe9, 0x28, 0x99, 0x39, 0x49, 0x47, 0xc9,
-	0xd9, 0x57, 0x60, 0x69, 0x64, 0x19, 0x1f, 0xa5, 0xf5, 0xf5, 0xec, 0xe7, 0x60, 0xee, 0x71, 0xbb,
-	0x66, 0xbf, 0x3f, 0xa3, 0x9d, 0xf4, 0x76, 0xe8, 0xb1, 0x56, 0x8a, 0x58, 0xaf, 0xa6, 0x88, 0xb0,
-	0x8a, 0xb2, 0x0d, 0xa3, 0xd7, 0x5f, 0x01, 0xb1, 0x29, 0x8f, 0x5a, 0x66, 0xe4, 0xc4, 0x24, 0x78,
-	0xa2, 0x96, 0xb9, 0xa3, 0x24, 0x60, 0x43, 0x1a, 0x22, 0xa2, 0x99, 0xb2, 0x3c, 0x75, 0x41, 0x49,
-	0xde, 0x02, 0x8c, 0x6d, 0xa8, 0x7c, 0xd7, 0x82, 0xc5, 0x20, 0x63, 0xaf, 0xa2, 0x9e, 0xf9, 0x6a,
-	0xe1, 0x1b, 0x81, 0xf7, 0x8f, 0x65, 0x61, 0x38, 0x27, 0x1c, 0xad, 0xc2, 0x29, 0xb9, 0x02, 0xd9,
-	0x3e, 0x0b, 0x95, 0x6b, 0xe3, 0x2c, 0x1a, 0xe7, 0xe9, 0x8d, 0x9e, 0xa8, 0xea, 0xa4, 0x9e, 0x28,
-	0xb4, 0xa7, 0xda, 0x1f, 0x67, 0x8b, 0x6d, 0x7f, 0x84, 0xd1, 0xd6, 0x47, 0xfb, 0x2f, 0x2d, 0x38,
-	0x2d, 0x47, 0x7d, 0x6b, 0x9f, 0xc4, 0xb1, 0xef, 0xb1, 0x73, 0x81, 0xa3, 0x75, 0x80, 0xa5, 0xce,
-	0x85, 0xeb, 0x12, 0x81, 0x35, 0x0d, 0x8d, 0xec, 0x78, 0x90, 0x95, 0xe4, 0xeb, 0xd3, 0x22, 0x78,
-	0xc3, 0x12, 0x4f, 0x33, 0xf7, 0xd1, 0x3e, 0xe1, 0x52, 0x36, 0x73, 0x3f, 0x49, 0x47, 0xaf, 0xfd,
-	0x5f, 0x16, 0x98, 0xbb, 0xe3, 0x64, 0xa7, 0xe6, 0xa7, 0x60, 0x76, 0x5f, 0x2c, 0x5d, 0xee, 0x6e,
-	0x4f, 0x2e, 0x99, 0xc4, 0xab, 0x03, 0xb6, 0x7c, 0xb2, 0xf8, 0xaa, 0xf2, 0x08, 0xf1, 0xd5, 0xcc,
-	0xc4, 0x13, 0xf9, 0x63, 0x50, 0x1e, 0xfa, 0x9e, 0x08, 0x91, 0x74, 0x1d, 0x74, 0x63, 0x1d, 0x53,
-	0xb8, 0xfd, 0xaf, 0x65, 0x9d, 0x0c, 0x89, 0x7a, 0xfb, 0x8f, 0xc5, 0xb4, 0x2f, 0xa9, 0xab, 0x59,
-	0x3e, 0xf3, 0x17, 0xb2, 0x57, 0xb3, 0x0f, 0x0e, 0x97, 0x81, 0x4f, 0x97, 0x5d, 0x92, 0x8d, 0xb9,
-	0xa8, 0x9d, 0x3d, 0xe6, 0x56, 0xe4, 0x32, 0xd4, 0x68, 0x4c, 0xc8, 0xaa, 0x13, 0xb5, 0x8c, 0x88,
-	0xda, 0x75, 0x01, 0x7f, 0x60, 0xfc, 0xc6, 0x8a, 0x1a, 0xad, 0x42, 0x9d, 0xfe, 0x66, 0xd7, 0x31,
-	0x22, 0x76, 0xbc, 0xa0, 0xf6, 0x82, 0x44, 0x8c, 0xb9, 0xb9, 0xd1, 0x6f, 0x51, 0x85, 0xb1, 0x4e,
-	0x79, 0xc6, 0x02, 0xb2, 0x0a, 0x6b, 0x4b, 0x04, 0xd6, 0x34, 0xf6, 0x87, 0xc6, 0x32, 0x8b, 0xcb,
-	0xeb, 0x1f, 0x8b, 0x65, 0xbe, 0x9c, 0x5b, 0xe6, 0xf3, 0x23, 0xcb, 0xbc, 0xa8, 0x5b, 0xc3, 0x33,
-	0x4b, 0xfd, 0x34, 0x7d, 0xe2, 0x09, 0x52, 0x0b, 0x76, 0x12, 0xbc, 0x35, 0xf4, 0x63, 0x92, 0xec,
-	0xc4, 0xc3, 0xc0, 0x0f, 0xba, 0xcc, 0x34, 0x6a, 0xe6, 0x49, 0x90, 0x41, 0xe3, 0x3c, 0xbd, 0xfd,
-	0xe7, 0x25, 0x9a, 0xe1, 0x66, 0x5a, 0xc5, 0xd1, 0x4b, 0x50, 0x93, 0xdf, 0x02, 0xe4, 0x8b, 0x6e,
-	0xea, 0x7b, 0x62, 0x45, 0x81, 0xbe, 0x04, 0xe0, 0x91, 0xa8, 0x1f, 0x1e, 0xb0, 0xcb, 0xb0, 0xca,
-	0x23, 0x5f, 0x86, 0xa9, 0x53, 0x7e, 0x5d, 0x71, 0xc1, 0x06, 0x47, 0x74, 0x16, 0x4a, 0xbe, 0xc7,
-	0x56, 0xb3, 0xdc, 0x02, 0x41, 0x5b, 0xda, 0x58, 0xc7, 0x25, 0xdf, 0x33, 0x9a, 0xa2, 0xaa, 0x4f,
-	0xaf, 0x29, 0xca, 0xfe, 0x3b, 0x76, 0x58, 0xf1, 0xe9, 0x6f, 0xc9, 0x42, 0xd4, 0x27, 0xa0, 0xea,
-	0x0c, 0xd3, 0x5e, 0x38, 0xd2, 0x47, 0xba, 0xca, 0xa0, 0x58, 0x60, 0xd1, 0x26, 0x54, 0x3c, 0x9a,
-	0xb1, 0x95, 0x1e, 0x59, 0x51, 0x3a, 0xfd, 0xa4, 0xf9, 0x1c, 0xe3, 0x82, 0x5e, 0x80, 0x4a, 0xea,
-	0x74, 0xe5, 0xf5, 0x1b, 0xbb, 0x09, 0xdc, 0x75, 0xba, 0x09, 0x66, 0x50, 0xd3, 0x33, 0x55, 0x8e,
-	0x69, 0x21, 0xf9, 0xb3, 0x0a, 0x2c, 0x64, 0xee, 0x58, 0x33, 0x56, 0x60, 0x1d, 0x6b, 0x05, 0x17,
-	0x60, 0x26, 0x8a, 0x87, 0x01, 0x9f, 0x57, 0x4d, 0x3b, 0x06, 0x6a, 0x67, 0x04, 0x73, 0x1c, 0xd5,
-	0x91, 0x17, 0x1f, 0xe0, 0x61, 0x20, 0xaa, 0x52, 0x4a, 0x47, 0xeb, 0x0c, 0x8a, 0x05, 0x16, 0x7d,
-	0x19, 0xe6, 0x13, 0xb6, 0x01, 0x63, 0x27, 0x25, 0x5d, 0xf9, 0xf9, 0xd0, 0xb5, 0xa9, 0x3f, 0xf5,
-	0xe0, 0xec, 0x78, 0x7c, 0x6f, 0x42, 0x70, 0x46, 0x1c, 0xfa, 0x9a, 0x65, 0x7e, 0xde, 0x52, 0x9d,
-	0xba, 0x80, 0x9a, 0xbf, 0xbb, 0xe6, 0xd6, 0xf5, 0xf0, 0xaf, 0x5c, 0x22, 0x65, 0xd9, 0xb3, 0x4f,
-	0xc0, 0xb2, 0x61, 0x4c, 0xab, 0xdf, 0xa7, 0xa1, 0x3e, 0x70, 0x02, 0xbf, 0x43, 0x92, 0x94, 0xff,
-	0x43, 0x4a, 0x9d, 0x7f, 0x48, 0xbe, 0x25, 0x81, 0x58, 0xe3, 0xed, 0xaf, 0x5a, 0x70, 0x66, 0xec,
-	0xb4, 0x9e, 0x5a, 0xd5, 0x80, 0x7a, 0xae, 0x67, 0xc7, 0x74, 0x05, 0xa0, 0xfd, 0x27, 0xf3, 0x6d,
-	0x92, 0xe8, 0x39, 0x58, 0x98, 0xb8, 0x62, 0x8f, 0xe6, 0x35, 0xb5, 0xe7, 0x2a, 0x3f, 0x45, 0xcf,
-	0xf5, 0x9b, 0x16, 0x18, 0x5f, 0xce, 0xa1, 0x5f, 0x82, 0xba, 0x33, 0x4c, 0xc3, 0x81, 0x93, 0x12,
-	0x4f, 0x64, 0x8e, 0xdb, 0x85, 0x7c, 0xa3, 0xb7, 0x2a, 0xb9, 0x72, 0x7d, 0xa9, 0x47, 0xac, 0xe5,
-	0xd9, 0x3d, 0xbe, 0x7c, 0xb9, 0x17, 0xb4, 0x23, 0xb1, 0x1e, 0xe2, 0x48, 0x5e, 0x82, 0x5a, 0x42,
-	0xfa, 0x1d, 0x7a, 0x60, 0x0a, 0x87, 0xa3, 0x74, 0xdd, 0x16, 0x70, 0xac, 0x28, 0xec, 0x1f, 0x8a,
-	0x59, 0x8b, 0x18, 0xe6, 0x72, 0xae, 0x01, 0xef, 0xe4, 0xc7, 0xff, 0x01, 0x80, 0xab, 0x3a, 0x72,
-	0x0b, 0xf8, 0x00, 0x4d, 0xb7, 0xf7, 0x9a, 0x9f, 0x47, 0x49, 0x18, 0x36, 0x84, 0x65, 0xac, 0xab,
-	0x7c, 0x9c, 0x75, 0xd9, 0xff, 0x66, 0x41, 0xc6, 0xc1, 0xa1, 0x01, 0xcc, 0xd0, 0x11, 0x1c, 0x14,
-	0xd0, 0x3c, 0x6c, 0xf2, 0xa5, 0x96, 0x27, 0x6e, 0x4b, 0xd8, 0x4f, 0xcc, 0xa5, 0x20, 0x5f, 0x84,
-	0x2e, 0x5c, 0x45, 0x37, 0x0b, 0x92, 0x46, 0x23, 0x1f, 0xf1, 0xc7, 0x22, 0x2a, 0x06, 0xb2, 0x2f,
-	0xc3, 0xd2, 0xc8, 0x88, 0xa8, 0x11, 0xb1, 0xb6, 0xc1, 0xbc, 0x11, 0xb1, 0xc6, 0x42, 0xcc, 0x71,
-	0xf6, 0xb7, 0x2d, 0x38, 0x9d, 0x67, 0x8f, 0x7e, 0xcf, 0x82, 0xa5, 0x24, 0xcf, 0xef, 0x89, 0x68,
-	0x4d, 0xa5, 0x99, 0x23, 0x28, 0x3c, 0x3a, 0x02, 0xfb, 0x6f, 0x4b, 0xdc, 0x86, 0xf9, 0xff, 0x4a,
-	0x29, 0x07, 0x6a, 0x4d, 0x74, 0xa0, 0x74, 0x8b, 0xb8, 0x3d, 0xe2, 0x0d, 0xfb, 0x23, 0x37, 0xa7,
-	0x6d, 0x01, 0xc7, 0x8a, 0x82, 0xdd, 0x18, 0x0d, 0x45, 0x1b, 0x5a, 0xce, 0xbc, 0xd6, 0x05, 0x1c,
-	0x2b, 0x0a, 0x74, 0x09, 0xe6, 0x8d, 0x49, 0xf2, 0x7a, 0x9c, 0x28, 0x9b, 0x19, 0xbe, 0x28, 0xc1,
-	0x19, 0xaa, 0xdc, 0x07, 0x1e, 0x33, 0xc7, 0x7d, 0xe0, 0xc1, 0xae, 0x65, 0x79, 0xc7, 0xbd, 0x2c,
-	0x53, 0xf0, 0x6b, 0x59, 0x01, 0xc3, 0x0a, 0x8b, 0x2e, 0x02, 0x0c, 0x9c, 0x60, 0xe8, 0xf4, 0xa9,
-	0x86, 0xc4, 0x3d, 0xbf, 0xda, 0x50, 0x5b, 0x0a, 0x83, 0x0d, 0x2a, 0xba, 0x45, 0xf2, 0x9f, 0x4b,
-	0x64, 0xba, 0x05, 0xac, 0x63, 0xbb, 0x05, 0xb2, 0xf7, 0xd9, 0xa5, 0x13, 0xdd, 0x67, 0x9b, 0x57,
-	0xcd, 0xe5, 0x87, 0x5e, 0x35, 0x7f, 0x1c, 0x66, 0xf7, 0xc8, 0x81, 0x71, 0x27, 0xcd, 0xff, 0x56,
-	0x86, 0x83, 0xb0, 0xc4, 0x21, 0x1b, 0xaa, 0xae, 0xa3, 0xda, 0x7d, 0xe6, 0xf9, 0xc9, 0xbe, 0xb6,
-	0xca, 0x88, 0x04, 0xa6, 0xd5, 0x7c, 0xff, 0xc3, 0x73, 0xcf, 0x7c, 0xf7, 0xc3, 0x73, 0xcf, 0x7c,
-	0xf0, 0xe1, 0xb9, 0x67, 0xbe, 0x7a, 0x74, 0xce, 0x7a, 0xff, 0xe8, 0x9c, 0xf5, 0xdd, 0xa3, 0x73,
-	0xd6, 0x07, 0x47, 0xe7, 0xac, 0x7f, 0x39, 0x3a, 0x67, 0xfd, 0xce, 0x0f, 0xce, 0x3d, 0xf3, 0x46,
-	0x4d, 0xda, 0xea, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x1a, 0xcd, 0x95, 0x15, 0x54, 0x00,
-	0x00,
+	0x55, 0x5b, 0xdd, 0x3d, 0xd3, 0xdd, 0x67, 0x1e, 0xf6, 0x5c, 0xaf, 0x37, 0x1d, 0xb3, 0xf1, 0x58,
+	0x65, 0x25, 0xd9, 0x90, 0xa4, 0x87, 0x5d, 0x6d, 0xc0, 0x01, 0x94, 0x30, 0x3d, 0xe3, 0xc7, 0xd8,
+	0x33, 0xe3, 0xd9, 0xdb, 0xb3, 0x6b, 0x29, 0x09, 0x61, 0xcb, 0x55, 0xb7, 0xbb, 0x6b, 0xa7, 0xbb,
+	0xaa, 0xb6, 0xaa, 0x7a, 0xec, 0x59, 0x48, 0x08, 0x8f, 0xa0, 0x10, 0xb2, 0x08, 0x09, 0xf1, 0x85,
+	0xc2, 0xeb, 0x8f, 0x88, 0x1f, 0x14, 0x09, 0xf8, 0xde, 0x0f, 0xd8, 0x2f, 0x14, 0xa2, 0x08, 0x56,
+	0x80, 0x46, 0xec, 0xe4, 0x07, 0xc1, 0x47, 0x88, 0x10, 0x3f, 0xfe, 0x42, 0xf7, 0x7d, 0xab, 0xba,
+	0xdb, 0xd3, 0x76, 0x97, 0x1d, 0x14, 0xbe, 0xa6, 0xeb, 0x9c, 0x53, 0xe7, 0xdc, 0xc7, 0xb9, 0xe7,
+	0x9e, 0x57, 0x0d, 0x6c, 0x75, 0xfd, 0xb4, 0x37, 0xbc, 0xdb, 0x74, 0xc3, 0xc1, 0x9a, 0x13, 0x77,
+	0xc3, 0x28, 0x0e, 0xdf, 0x60, 0x3f, 0x3e, 0xe9, 0x7a, 0x6b, 0xd1, 0x41, 0x77, 0xcd, 0x89, 0xfc,
+	0x64, 0xcd, 0x89, 0xa2, 0xbe, 0xef, 0x3a, 0xa9, 0x1f, 0x06, 0x6b, 0x87, 0x2f, 0x3a, 0xfd, 0xa8,
+	0xe7, 0xbc, 0xb8, 0xd6, 0x25, 0x01, 0x89, 0x9d, 0x94, 0x78, 0xcd, 0x28, 0x0e, 0xd3, 0x10, 0x7d,
+	0x5a, 0xb3, 0x6a, 0x4a, 0x56, 0xec, 0xc7, 0x2f, 0xb9, 0x5e, 0x33, 0x3a, 0xe8, 0x36, 0x29, 0xab,
+	0xa6, 0xc1, 0xaa, 0x29, 0x59, 0x5d, 0xf8, 0xa4, 0x31, 0x8a, 0x6e, 0xd8, 0x0d, 0xd7, 0x18, 0xc7,
+	0xbb, 0xc3, 0x0e, 0x7b, 0x62, 0x0f, 0xec, 0x17, 0x97, 0x74, 0xc1, 0x3e, 0xb8, 0x92, 0x34, 0xfd,
+	0x90, 0x8e, 0x6d, 0xcd, 0x0d, 0x63, 0xb2, 0x76, 0x38, 0x32, 0x9a, 0x0b, 0x2f, 0x6b, 0x9a, 0x81,
+	0xe3, 0xf6, 0xfc, 0x80, 0xc4, 0x47, 0x7a, 0x42, 0x03, 0x92, 0x3a, 0xe3, 0xde, 0x5a, 0x9b, 0xf4,
+	0x56, 0x3c, 0x0c, 0x52, 0x7f, 0x40, 0x46, 0x5e, 0xf8, 0xe9, 0xd3, 0x5e, 0x48, 0xdc, 0x1e, 0x19,
+	0x38, 0xf9, 0xf7, 0xec, 0x37, 0x61, 0x69, 0xfd, 0x4e, 0x7b, 0x7d, 0x98, 0xf6, 0x36, 0xc2, 0xa0,
+	0xe3, 0x77, 0xd1, 0xa7, 0x60, 0xc1, 0xed, 0x0f, 0x93, 0x94, 0xc4, 0xbb, 0xce, 0x80, 0x34, 0xac,
+	0x4b, 0xd6, 0x0b, 0xf5, 0xd6, 0xb9, 0x77, 0x8f, 0x57, 0x9f, 0x39, 0x39, 0x5e, 0x5d, 0xd8, 0xd0,
+	0x28, 0x6c, 0xd2, 0xa1, 0x8f, 0x41, 0x35, 0x0e, 0xfb, 0x64, 0x1d, 0xef, 0x36, 0x4a, 0xec, 0x95,
+	0x33, 0xe2, 0x95, 0x2a, 0xe6, 0x60, 0x2c, 0xf1, 0xf6, 0xbf, 0x58, 0x00, 0xeb, 0x51, 0xb4, 0x17,
+	0x87, 0x6f, 0x10, 0x37, 0x45, 0xaf, 0x43, 0x8d, 0xae, 0x82, 0xe7, 0xa4, 0x0e, 0x93, 0xb6, 0xf0,
+	0xd2, 0x4f, 0x35, 0xf9, 0x64, 0x9a, 0xe6, 0x64, 0xf4, 0xce, 0x51, 0xea, 0xe6, 0xe1, 0x8b, 0xcd,
+	0xdb, 0x77, 0xe9, 0xfb, 0x3b, 0x24, 0x75, 0x5a, 0x48, 0x08, 0x03, 0x0d, 0xc3, 0x8a, 0x2b, 0x3a,
+	0x80, 0x4a, 0x12, 0x11, 0x97, 0x0d, 0x6c, 0xe1, 0xa5, 0xad, 0xe6, 0x63, 0xeb, 0x47, 0x53, 0x0f,
+	0xbb, 0x1d, 0x11, 0xb7, 0xb5, 0x28, 0xc4, 0x56, 0xe8, 0x13, 0x66, 0x42, 0xec, 0x7f, 0xb6, 0x60,
+	0x59, 0x93, 0x6d, 0xfb, 0x49, 0x8a, 0xbe, 0x30, 0x32, 0xc3, 0xe6, 0x74, 0x33, 0xa4, 0x6f, 0xb3,
+	0xf9, 0x9d, 0x15, 0x82, 0x6a, 0x12, 0x62, 0xcc, 0xee, 0x0d, 0x98, 0xf3, 0x53, 0x32, 0x48, 0x1a,
+	0xa5, 0x4b, 0xe5, 0x17, 0x16, 0x5e, 0xba, 0x5a, 0xc8, 0xf4, 0x5a, 0x4b, 0x42, 0xe2, 0xdc, 0x16,
+	0xe5, 0x8d, 0xb9, 0x08, 0xfb, 0x6f, 0xaa, 0xe6, 0xe4, 0xe8, 0xac, 0xd1, 0x8b, 0xb0, 0x90, 0x84,
+	0xc3, 0xd8, 0x25, 0x98, 0x44, 0x61, 0xd2, 0xb0, 0x2e, 0x95, 0xe9, 0xe6, 0x53, 0x5d, 0x69, 0x6b,
+	0x30, 0x36, 0x69, 0xd0, 0xef, 0x58, 0xb0, 0xe8, 0x91, 0x24, 0xf5, 0x03, 0x26, 0x5f, 0x8e, 0xfc,
+	0x95, 0xd9, 0x46, 0x2e, 0x81, 0x9b, 0x9a, 0x73, 0xeb, 0x59, 0x31, 0x8b, 0x45, 0x03, 0x98, 0xe0,
+	0x8c, 0x70, 0xaa, 0xf0, 0x1e, 0x49, 0xdc, 0xd8, 0x8f, 0xe8, 0x73, 0xa3, 0x9c, 0x55, 0xf8, 0x4d,
+	0x8d, 0xc2, 0x26, 0x1d, 0x3a, 0x80, 0x39, 0xaa, 0xd0, 0x49, 0xa3, 0xc2, 0x06, 0x7f, 0x6d, 0x86,
+	0xc1, 0x8b, 0xe5, 0xa4, 0x07, 0x45, 0xaf, 0x3b, 0x7d, 0x4a, 0x30, 0x97, 0x81, 0xde, 0xb6, 0xa0,
+	0x21, 0x4e, 0x1b, 0x26, 0x7c, 0x29, 0xef, 0xf4, 0xfc, 0x94, 0xf4, 0xfd, 0x24, 0x6d, 0xcc, 0xb1,
+	0x01, 0xac, 0x4d, 0xa7, 0x52, 0xd7, 0xe3, 0x70, 0x18, 0xdd, 0xf2, 0x03, 0xaf, 0x75, 0x49, 0x48,
+	0x6a, 0x6c, 0x4c, 0x60, 0x8c, 0x27, 0x8a, 0x44, 0xbf, 0x6f, 0xc1, 0x85, 0xc0, 0x19, 0x90, 0x24,
+	0x72, 0x5c, 0x22, 0xd1, 0xad, 0xbe, 0xe3, 0x1e, 0xb0, 0x11, 0xcd, 0x3f, 0xde, 0x88, 0x6c, 0x31,
+	0xa2, 0x0b, 0xbb, 0x13, 0x59, 0xe3, 0x87, 0x88, 0x45, 0x7f, 0x62, 0xc1, 0x4a, 0x18, 0x47, 0x3d,
+	0x27, 0x20, 0x9e, 0xc4, 0x26, 0x8d, 0x2a, 0x3b, 0x71, 0x9f, 0x9f, 0x61, 0x7f, 0x6e, 0xe7, 0x79,
+	0xee, 0x84, 0x81, 0x9f, 0x86, 0x71, 0x9b, 0xa4, 0xa9, 0x1f, 0x74, 0x93, 0xd6, 0xf9, 0x93, 0xe3,
+	0xd5, 0x95, 0x11, 0x2a, 0x3c, 0x3a, 0x18, 0x74, 0x1f, 0x16, 0x92, 0xa3, 0xc0, 0xbd, 0xe3, 0x07,
+	0x5e, 0x78, 0x2f, 0x69, 0xd4, 0x66, 0x3e, 0xb2, 0x6d, 0xc5, 0x4d, 0x1c, 0x3a, 0xcd, 0x1d, 0x9b,
+	0xa2, 0xec, 0xbf, 0x2d, 0xc3, 0x82, 0x71, 0x4a, 0x9e, 0x82, 0xd9, 0xed, 0x67, 0xcc, 0xee, 0xcd,
+	0x62, 0x4e, 0xf7, 0x24, 0xbb, 0x8b, 0x52, 0x98, 0x4f, 0x52, 0x27, 0x1d, 0x26, 0xec, 0x04, 0x2f,
+	0xbc, 0xb4, 0x5d, 0x90, 0x3c, 0xc6, 0xb3, 0xb5, 0x2c, 0x24, 0xce, 0xf3, 0x67, 0x2c, 0x64, 0xa1,
+	0x37, 0xa1, 0x1e, 0x46, 0xf4, 0x42, 0xa5, 0xa6, 0xa3, 0xc2, 0x04, 0x6f, 0xce, 0xa2, 0x69, 0x92,
+	0x57, 0x6b, 0xe9, 0xe4, 0x78, 0xb5, 0xae, 0x1e, 0xb1, 0x96, 0x62, 0xff, 0x93, 0x05, 0xcf, 0x1a,
+	0x03, 0xdc, 0x08, 0x03, 0xcf, 0x67, 0x3b, 0x7a, 0x09, 0x2a, 0xe9, 0x51, 0x24, 0xaf, 0x6c, 0xb5,
+	0x46, 0xfb, 0x47, 0x11, 0xc1, 0x0c, 0x43, 0x2f, 0xe9, 0x01, 0x49, 0x12, 0xa7, 0x4b, 0xf2, 0x97,
+	0xf4, 0x0e, 0x07, 0x63, 0x89, 0x47, 0x31, 0xa0, 0xbe, 0x93, 0xa4, 0xfb, 0xb1, 0x13, 0x24, 0x8c,
+	0xfd, 0xbe, 0x3f, 0x20, 0x62, 0x69, 0x7f, 0x72, 0x3a, 0x45, 0xa1, 0x6f, 0xb4, 0x9e, 0x3b, 0x39,
+	0x5e, 0x45, 0xdb, 0x23, 0x9c, 0xf0, 0x18, 0xee, 0xf6, 0x9b, 0xf0, 0xdc, 0x78, 0x3b, 0x8e, 0x3e,
+	0x02, 0xf3, 0x09, 0x89, 0x0f, 0x49, 0x2c, 0x26, 0xa7, 0xb7, 0x83, 0x41, 0xb1, 0xc0, 0xa2, 0x35,
+	0xa8, 0x2b, 0xfb, 0x20, 0xa6, 0xb8, 0x22, 0x48, 0xeb, 0xda, 0xa8, 0x68, 0x1a, 0xfb, 0x5f, 0x2d,
+	0x38, 0x63, 0xc8, 0x7c, 0x0a, 0xd7, 0xf5, 0x41, 0xf6, 0xba, 0xbe, 0x56, 0x8c, 0x9a, 0x4e, 0xb8,
+	0xaf, 0xbf, 0x3d, 0x0f, 0x2b, 0xa6, 0x32, 0x33, 0x2b, 0xc4, 0x7c, 0x35, 0x12, 0x85, 0xaf, 0xe2,
+	0xed, 0x86, 0x95, 0x55, 0x03, 0xcc, 0xc1, 0x58, 0xe2, 0xa9, 0x4e, 0x45, 0x4e, 0xda, 0x6b, 0x94,
+	0xb2, 0x3a, 0xb5, 0xe7, 0xa4, 0x3d, 0xcc, 0x30, 0xe8, 0x33, 0xb0, 0x9c, 0x3a, 0x71, 0x97, 0xa4,
+	0x98, 0x1c, 0xfa, 0x89, 0x3c, 0x06, 0xf5, 0xd6, 0x73, 0x82, 0x76, 0x79, 0x3f, 0x83, 0xc5, 0x39,
+	0x6a, 0x14, 0x40, 0xa5, 0x47, 0xfa, 0x03, 0x61, 0xa6, 0xf7, 0x0a, 0x3a, 0xb5, 0x6c, 0xa2, 0x37,
+	0x48, 0x7f, 0xd0, 0xaa, 0xd1, 0xf1, 0xd2, 0x5f, 0x98, 0xc9, 0x41, 0xbf, 0x6e, 0x41, 0xfd, 0x60,
+	0x98, 0xa4, 0xe1, 0xc0, 0x7f, 0x8b, 0x34, 0x6a, 0x4c, 0xea, 0xab, 0x45, 0x4a, 0xbd, 0x25, 0x99,
+	0xf3, 0x33, 0xac, 0x1e, 0xb1, 0x16, 0x8b, 0xde, 0x82, 0xea, 0x41, 0x12, 0x06, 0x01, 0x49, 0x1b,
+	0x75, 0x36, 0x82, 0x76, 0xa1, 0x23, 0xe0, 0xac, 0x5b, 0x0b, 0x74, 0x4b, 0xc5, 0x03, 0x96, 0x02,
+	0xd9, 0x02, 0x78, 0x7e, 0x4c, 0xdc, 0x34, 0x8c, 0x8f, 0x1a, 0x50, 0xfc, 0x02, 0x6c, 0x4a, 0xe6,
+	0x7c, 0x01, 0xd4, 0x23, 0xd6, 0x62, 0xd1, 0x21, 0xcc, 0x47, 0xfd, 0x61, 0xd7, 0x0f, 0x1a, 0x0b,
+	0x6c, 0x00, 0xb8, 0xc8, 0x01, 0xec, 0x31, 0xce, 0x2d, 0xa0, 0x06, 0x82, 0xff, 0xc6, 0x42, 0x1a,
+	0xba, 0x0c, 0x73, 0x6e, 0xcf, 0x89, 0xd3, 0xc6, 0x22, 0x53, 0x52, 0x75, 0x6a, 0x36, 0x28, 0x10,
+	0x73, 0x9c, 0xfd, 0x77, 0x16, 0x5c, 0x98, 0x3c, 0x2b, 0x7e, 0x7c, 0xdc, 0x61, 0x9c, 0x70, 0x53,
+	0x5b, 0x33, 0x8f, 0x0f, 0x03, 0x63, 0x89, 0x47, 0x5f, 0x86, 0xea, 0x1b, 0x62, 0x9f, 0x4b, 0xc5,
+	0xef, 0xf3, 0x4d, 0xb1, 0xcf, 0x4a, 0xfe, 0x4d, 0xb9, 0xd7, 0x42, 0xa8, 0xfd, 0xed, 0x32, 0x9c,
+	0x1f, 0x7b, 0x2c, 0x50, 0x13, 0xe0, 0xd0, 0xe9, 0x0f, 0xc9, 0x35, 0xbf, 0x4f, 0xa4, 0xd7, 0xbe,
+	0x4c, 0xaf, 0xf2, 0xd7, 0x14, 0x14, 0x1b, 0x14, 0xe8, 0x57, 0x00, 0x22, 0x27, 0x76, 0x06, 0x24,
+	0x25, 0xb1, 0xb4, 0x5d, 0x37, 0x66, 0x98, 0x0c, 0x1d, 0xc4, 0x9e, 0x64, 0xa8, 0x1d, 0x09, 0x05,
+	0x4a, 0xb0, 0x21, 0x8f, 0xfa, 0xe8, 0x31, 0xe9, 0x13, 0x27, 0x21, 0xbb, 0x8e, 0xb8, 0x86, 0x0c,
+	0x1f, 0x1d, 0x6b, 0x14, 0x36, 0xe9, 0xe8, 0xb5, 0xc1, 0xa6, 0x90, 0x34, 0x2a, 0xd9, 0x6b, 0x83,
+	0x4d, 0x32, 0xc1, 0x02, 0x8b, 0xbe, 0x61, 0xc1, 0x72, 0xc7, 0xef, 0x13, 0x2d, 0x5d, 0x38, 0xd5,
+	0xdb, 0x33, 0xce, 0xf0, 0x9a, 0xc9, 0x54, 0x9b, 0xc4, 0x0c, 0x38, 0xc1, 0x39, 0xd9, 0xf6, 0xff,
+	0x58, 0xd0, 0x98, 0xb4, 0xd9, 0x28, 0x82, 0x2a, 0xb9, 0x9f, 0xbe, 0xe6, 0xc4, 0x7c, 0xd7, 0x66,
+	0xf3, 0x1e, 0x05, 0xd3, 0xd7, 0x9c, 0x58, 0x2b, 0xd1, 0x55, 0xce, 0x1d, 0x4b, 0x31, 0xa8, 0x0b,
+	0x95, 0xb4, 0xef, 0x14, 0x11, 0x5f, 0x1a, 0xe2, 0xb4, 0x7b, 0xb2, 0xbd, 0x9e, 0x60, 0x26, 0xc0,
+	0xfe, 0xee, 0xb8, 0x79, 0x0b, 0xfb, 0x45, 0x55, 0x80, 0x04, 0x87, 0x7e, 0x1c, 0x06, 0x03, 0x12,
+	0xa4, 0xf9, 0xbc, 0xc4, 0x55, 0x8d, 0xc2, 0x26, 0x1d, 0xfa, 0xd5, 0x31, 0x7a, 0x7b, 0x6b, 0x86,
+	0x29, 0x88, 0xe1, 0x4c, 0xad, 0xba, 0xf6, 0x1f, 0x97, 0xc7, 0x18, 0x13, 0x75, 0x29, 0xa0, 0x97,
+	0x00, 0xa8, 0x37, 0xb2, 0x17, 0x93, 0x8e, 0x7f, 0x5f, 0xcc, 0x4a, 0xb1, 0xdc, 0x55, 0x18, 0x6c,
+	0x50, 0xc9, 0x77, 0xda, 0xc3, 0x0e, 0x7d, 0xa7, 0x34, 0xfa, 0x0e, 0xc7, 0x60, 0x83, 0x0a, 0xbd,
+	0x0c, 0xf3, 0xfe, 0xc0, 0xe9, 0x12, 0xea, 0x1e, 0xd3, 0xb3, 0xfe, 0x3c, 0x3d, 0x06, 0x5b, 0x0c,
+	0xf2, 0xe0, 0x78, 0x75, 0x59, 0x0d, 0x88, 0x81, 0xb0, 0xa0, 0x45, 0x7f, 0x6a, 0xc1, 0xa2, 0x1b,
+	0x0e, 0x06, 0x61, 0xb0, 0xed, 0xdc, 0x25, 0x7d, 0x19, 0xec, 0x76, 0x9f, 0xc8, 0x7d, 0xd9, 0xdc,
+	0x30, 0x24, 0x5d, 0x0d, 0xd2, 0xf8, 0x48, 0xc7, 0xef, 0x26, 0x0a, 0x67, 0x86, 0x74, 0xe1, 0xb3,
+	0xb0, 0x32, 0xf2, 0x22, 0x3a, 0x0b, 0xe5, 0x03, 0x72, 0xc4, 0xd7, 0x13, 0xd3, 0x9f, 0xe8, 0x59,
+	0x98, 0x63, 0xa7, 0x9d, 0xaf, 0x17, 0xe6, 0x0f, 0x3f, 0x5b, 0xba, 0x62, 0xd9, 0x7f, 0x68, 0xc1,
+	0x07, 0x26, 0xdc, 0x21, 0xd4, 0xff, 0x09, 0x74, 0x1a, 0x4c, 0x29, 0x2d, 0x33, 0x35, 0x0c, 0x83,
+	0xbe, 0x08, 0x65, 0x12, 0x1c, 0x0a, 0xcd, 0xda, 0x98, 0x61, 0x61, 0xae, 0x06, 0x87, 0x7c, 0xd2,
+	0xd5, 0x93, 0xe3, 0xd5, 0xf2, 0xd5, 0xe0, 0x10, 0x53, 0xc6, 0xf6, 0x57, 0xe7, 0x33, 0x1e, 0x6a,
+	0x5b, 0xc6, 0x3a, 0x6c, 0x94, 0xc2, 0x3f, 0xdd, 0x2e, 0x72, 0x3f, 0x0c, 0xe7, 0x9a, 0x3d, 0x63,
+	0x21, 0x0b, 0x7d, 0xcd, 0x62, 0x99, 0x12, 0xe9, 0x94, 0x8b, 0x1b, 0xed, 0x09, 0x64, 0x6d, 0xcc,
+	0xe4, 0x8b, 0x04, 0x62, 0x53, 0x34, 0xbd, 0x82, 0x23, 0x9e, 0x34, 0x11, 0x77, 0x81, 0xb2, 0x5e,
+	0x32, 0x97, 0x22, 0xf1, 0x68, 0x08, 0x40, 0xc3, 0xe0, 0xbd, 0xb0, 0xef, 0xbb, 0x47, 0x22, 0x44,
+	0x9b, 0x35, 0xe0, 0xe6, 0xcc, 0xf8, 0x7d, 0xa9, 0x9f, 0xb1, 0x21, 0x08, 0x7d, 0xd3, 0x82, 0x15,
+	0xbf, 0x1b, 0x84, 0x31, 0xd9, 0xf4, 0x3b, 0x1d, 0x12, 0x93, 0xc0, 0x25, 0xf2, 0x56, 0xd9, 0x9f,
+	0x41, 0xbc, 0x4c, 0x25, 0x6c, 0xe5, 0x79, 0xb7, 0x3e, 0x28, 0x96, 0x60, 0x65, 0x04, 0x85, 0x47,
+	0x47, 0x82, 0x1c, 0xa8, 0xf8, 0x41, 0x27, 0x14, 0xa9, 0x9a, 0xcf, 0xce, 0x30, 0xa2, 0xad, 0xa0,
+	0x13, 0xea, 0x93, 0x41, 0x9f, 0x30, 0x63, 0x8d, 0xb6, 0xe1, 0xd9, 0x58, 0x78, 0xf9, 0x37, 0xfc,
+	0x84, 0xba, 0x4e, 0xdb, 0xfe, 0xc0, 0x4f, 0x99, 0xa7, 0x5f, 0x6e, 0x35, 0x4e, 0x8e, 0x57, 0x9f,
+	0xc5, 0x63, 0xf0, 0x78, 0xec, 0x5b, 0xf6, 0x7f, 0xd7, 0xb2, 0xa1, 0x0c, 0x8f, 0xbf, 0xdf, 0x82,
+	0x7a, 0xac, 0x32, 0x3d, 0xfc, 0x3e, 0xdc, 0x2a, 0x60, 0x75, 0x45, 0xd4, 0xaf, 0x62, 0x47, 0x9d,
+	0xd3, 0xd1, 0xe2, 0xe8, 0xbd, 0x48, 0x37, 0x5c, 0x9c, 0x83, 0x59, 0x75, 0x4a, 0x88, 0xd4, 0xa9,
+	0x8d, 0xa3, 0x80, 0xa6, 0x36, 0x8e, 0x02, 0x17, 0x85, 0x30, 0xdf, 0x23, 0x4e, 0x3f, 0xed, 0x89,
+	0xf8, 0xfb, 0xfa, 0x4c, 0x5e, 0x09, 0x65, 0x94, 0xcf, 0x6a, 0x70, 0x28, 0x16, 0x62, 0xd0, 0x10,
+	0xaa, 0x3d, 0xbe, 0xf6, 0xc2, 0xe0, 0xdf, 0x9c, 0x69, 0x4d, 0x33, 0xbb, 0xa9, 0x8f, 0xaa, 0x00,
+	0x60, 0x29, 0x0b, 0xfd, 0x86, 0x05, 0xe0, 0xca, 0x74, 0x86, 0x3c, 0x2c, 0xb7, 0x8b, 0xb1, 0x2f,
+	0x2a, 0x4d, 0xa2, 0x6f, 0x4a, 0x05, 0x4a, 0xb0, 0x21, 0x16, 0xbd, 0x0e, 0x8b, 0x31, 0x71, 0xc3,
+	0xc0, 0xf5, 0xfb, 0xc4, 0x5b, 0xa7, 0xc9, 0xcc, 0x47, 0xcd, 0x79, 0x9c, 0xa5, 0x37, 0x16, 0x36,
+	0x78, 0xe0, 0x0c, 0x47, 0xf4, 0x55, 0x0b, 0x96, 0x55, 0x3e, 0x87, 0x6e, 0x05, 0x11, 0xd1, 0xef,
+	0x56, 0x11, 0xa9, 0x23, 0xc6, 0xb0, 0x85, 0xa8, 0x9f, 0x99, 0x85, 0xe1, 0x9c, 0x50, 0xf4, 0x39,
+	0x80, 0xf0, 0x2e, 0xcb, 0x9c, 0xd0, 0x79, 0xd6, 0x1e, 0x79, 0x9e, 0xcb, 0x3c, 0xf5, 0x27, 0x39,
+	0x60, 0x83, 0x1b, 0xba, 0x05, 0xc0, 0xcf, 0x09, 0x4d, 0x3f, 0xb1, 0x20, 0xb7, 0xde, 0xfa, 0xb8,
+	0x5c, 0xf9, 0xb6, 0xc2, 0x3c, 0x38, 0x5e, 0x1d, 0x0d, 0x50, 0x28, 0x02, 0x1b, 0xaf, 0xa3, 0xfb,
+	0x50, 0x4d, 0x86, 0x83, 0x81, 0xa3, 0xe2, 0xd5, 0x9d, 0x82, 0x2e, 0x3c, 0xce, 0x54, 0xab, 0xa4,
+	0x00, 0x60, 0x29, 0xce, 0x0e, 0x00, 0x8d, 0xd2, 0xa3, 0x97, 0x61, 0x91, 0xdc, 0x4f, 0x49, 0x1c,
+	0x38, 0xfd, 0x57, 0xf1, 0xb6, 0x0c, 0x9f, 0xd8, 0xb6, 0x5f, 0x35, 0xe0, 0x38, 0x43, 0x85, 0x6c,
+	0xe5, 0x82, 0x95, 0x18, 0x3d, 0x68, 0x17, 0x4c, 0x3a, 0x5c, 0xf6, 0x6f, 0x95, 0x32, 0xb7, 0xfd,
+	0x7e, 0x4c, 0x08, 0xea, 0xc3, 0x5c, 0x10, 0x7a, 0xca, 0xbe, 0x5d, 0x2f, 0xc0, 0xbe, 0xed, 0x86,
+	0x9e, 0x51, 0x6a, 0xa0, 0x4f, 0x09, 0xe6, 0x42, 0xd0, 0x6f, 0x5a, 0xb0, 0x24, 0xf3, 0xd6, 0x0c,
+	0xd1, 0x28, 0x15, 0x2b, 0xf6, 0xbc, 0x10, 0xbb, 0x74, 0xdb, 0x94, 0x82, 0xb3, 0x42, 0xed, 0xef,
+	0x5b, 0x99, 0xc8, 0xf5, 0x8e, 0x93, 0xba, 0xbd, 0xab, 0x87, 0xd4, 0xa3, 0xbf, 0x95, 0x49, 0x73,
+	0xfe, 0x8c, 0x99, 0xe6, 0x7c, 0x70, 0xbc, 0xfa, 0xd1, 0x49, 0x75, 0xd0, 0x7b, 0x94, 0x43, 0x93,
+	0xb1, 0x30, 0x32, 0xa2, 0x5f, 0x82, 0x05, 0x63, 0xc4, 0xc2, 0x94, 0x17, 0x95, 0x93, 0x53, 0x7e,
+	0x8c, 0x01, 0xc4, 0xa6, 0x3c, 0xfb, 0x9d, 0x32, 0x54, 0x45, 0xf9, 0x65, 0xea, 0x1c, 0xa7, 0x74,
+	0x49, 0x4b, 0x13, 0x5d, 0xd2, 0x08, 0xe6, 0x5d, 0x56, 0xcc, 0x15, 0xf7, 0xc5, 0x2c, 0x71, 0xba,
+	0x18, 0x1d, 0x2f, 0x0e, 0xeb, 0x31, 0xf1, 0x67, 0x2c, 0xe4, 0xd0, 0xfa, 0xd4, 0x19, 0x97, 0x06,
+	0x46, 0xae, 0x36, 0x69, 0x95, 0x99, 0xd3, 0xfe, 0x1b, 0x59, 0x8e, 0xad, 0x0f, 0x08, 0xe9, 0x67,
+	0x72, 0x08, 0x9c, 0x97, 0x8d, 0x7e, 0x0e, 0x96, 0xf8, 0x6a, 0xbd, 0x46, 0x62, 0x96, 0x93, 0x9c,
+	0x63, 0x8b, 0xa5, 0x54, 0xaf, 0x6d, 0x22, 0x71, 0x96, 0x96, 0xa6, 0x46, 0x54, 0x82, 0x38, 0x69,
+	0xcc, 0xeb, 0xd4, 0x88, 0xca, 0x20, 0x27, 0xd8, 0xa0, 0xb0, 0xff, 0xaa, 0x0c, 0x4b, 0x99, 0x65,
+	0x42, 0x9f, 0x80, 0xda, 0x30, 0x21, 0xb1, 0x11, 0x39, 0xa8, 0x8c, 0xf0, 0xab, 0x02, 0x8e, 0x15,
+	0x05, 0xa5, 0x8e, 0x9c, 0x24, 0xb9, 0x17, 0xc6, 0x5e, 0xa3, 0x94, 0xa5, 0xde, 0x13, 0x70, 0xac,
+	0x28, 0x68, 0x1c, 0x7c, 0x97, 0x38, 0x31, 0x89, 0xf7, 0xc3, 0x03, 0x32, 0x52, 0xae, 0x6c, 0x69,
+	0x14, 0x36, 0xe9, 0xd8, 0x0e, 0xa5, 0xfd, 0x64, 0xa3, 0xef, 0x93, 0x20, 0xe5, 0xc3, 0x2c, 0x60,
+	0x87, 0xf6, 0xb7, 0xdb, 0x26, 0x47, 0xbd, 0x43, 0x39, 0x04, 0xce, 0xcb, 0x46, 0xbf, 0x66, 0xc1,
+	0x92, 0x73, 0x2f, 0xd1, 0x8d, 0x07, 0x8d, 0xb9, 0x99, 0x75, 0x35, 0xd3, 0xc8, 0xd0, 0x5a, 0xa1,
+	0x1b, 0x9d, 0x01, 0xe1, 0xac, 0x44, 0xfb, 0x7b, 0x16, 0xc8, 0x86, 0x86, 0xa7, 0x90, 0xf8, 0xef,
+	0x66, 0x13, 0xff, 0xad, 0xd9, 0x0f, 0xe5, 0x84, 0xa4, 0xff, 0x2e, 0x54, 0x69, 0x40, 0xec, 0x04,
+	0x1e, 0xfa, 0x30, 0x54, 0x5d, 0xfe, 0x53, 0xdc, 0x51, 0x2c, 0x25, 0x2c, 0xb0, 0x58, 0xe2, 0xd0,
+	0xf3, 0x50, 0x71, 0xe2, 0xae, 0xbc, 0x97, 0x58, 0xc6, 0x7c, 0x3d, 0xee, 0x26, 0x98, 0x41, 0xed,
+	0xb7, 0x4b, 0x00, 0x1b, 0xe1, 0x20, 0x72, 0x62, 0xe2, 0xed, 0x87, 0xff, 0xef, 0x83, 0x4f, 0xfb,
+	0x1b, 0x16, 0x20, 0xba, 0x1e, 0x61, 0x40, 0x02, 0x9d, 0x08, 0xa2, 0xb5, 0x27, 0x57, 0x42, 0xc5,
+	0xa9, 0x57, 0xf1, 0x83, 0x22, 0xc7, 0x9a, 0x66, 0x0a, 0x43, 0x7e, 0x59, 0xe6, 0x2c, 0xca, 0xd9,
+	0x6c, 0x35, 0x4b, 0x5f, 0x8a, 0x14, 0x86, 0xfd, 0xbb, 0x25, 0x78, 0x8e, 0x2b, 0xf4, 0x8e, 0x13,
+	0x38, 0x5d, 0x42, 0xd3, 0x5e, 0x53, 0x67, 0x2f, 0x5e, 0xa7, 0x61, 0xa0, 0x2f, 0xb3, 0xd3, 0x33,
+	0xe9, 0x24, 0xd7, 0x25, 0xae, 0x3d, 0x5b, 0x81, 0x9f, 0x62, 0xc6, 0x19, 0x45, 0x50, 0x93, 0x3d,
+	0x47, 0x8d, 0x72, 0x61, 0x52, 0xd4, 0x41, 0xbb, 0x2e, 0x78, 0x63, 0x25, 0xc5, 0x7e, 0xc7, 0x82,
+	0xfc, 0x0d, 0xc1, 0x2e, 0x57, 0x5e, 0x1d, 0xce, 0x5f, 0xae, 0xd9, 0x7a, 0xee, 0x23, 0x54, 0x48,
+	0xbf, 0x00, 0x0b, 0x4e, 0x9a, 0x92, 0x41, 0x94, 0x32, 0xf7, 0xb9, 0xfc, 0x78, 0xee, 0xf3, 0x4e,
+	0xe8, 0xf9, 0x1d, 0x9f, 0xb9, 0xcf, 0x26, 0x3b, 0xfb, 0x15, 0xa8, 0xc9, 0x84, 0xd0, 0x14, 0xdb,
+	0x78, 0x39, 0x93, 0xdc, 0x9a, 0xa0, 0x28, 0x0e, 0x2c, 0x9a, 0xd1, 0xdf, 0x13, 0x58, 0x13, 0xfb,
+	0x0e, 0xac, 0x8c, 0xa4, 0xbd, 0xa7, 0x18, 0xfe, 0xa9, 0x55, 0x46, 0xfb, 0x6d, 0x0b, 0x96, 0x32,
+	0x25, 0x83, 0x82, 0x16, 0x85, 0x5e, 0xa7, 0x9d, 0x90, 0x45, 0xfc, 0xb1, 0x1f, 0x70, 0x87, 0xa9,
+	0xa6, 0x6d, 0xc0, 0x35, 0x8d, 0xc2, 0x26, 0x9d, 0xbd, 0x03, 0x2c, 0xd3, 0x51, 0xd4, 0xd6, 0xbc,
+	0x02, 0x35, 0xca, 0x8e, 0x9a, 0xf1, 0xa2, 0x58, 0xb6, 0xa1, 0x76, 0xf3, 0xce, 0x3e, 0xbf, 0xfc,
+	0x6d, 0x28, 0xfb, 0x0e, 0x37, 0x4a, 0x65, 0x7d, 0x74, 0xb6, 0x92, 0x64, 0xc8, 0x14, 0x8f, 0x22,
+	0xd1, 0x65, 0x28, 0x93, 0xfb, 0x11, 0x63, 0x59, 0xd6, 0x86, 0xeb, 0xea, 0xfd, 0xc8, 0x8f, 0x49,
+	0x42, 0x89, 0xc8, 0xfd, 0xc8, 0x1e, 0x02, 0xe8, 0x1c, 0x7e, 0x51, 0x5b, 0x70, 0x09, 0x2a, 0x6e,
+	0xe8, 0x11, 0xb1, 0xf6, 0x8a, 0xcd, 0x46, 0xe8, 0x11, 0xcc, 0x30, 0xf6, 0xd7, 0x2d, 0x38, 0x9b,
+	0x4f, 0xbc, 0xff, 0xc8, 0xec, 0xed, 0x36, 0x9c, 0x55, 0x29, 0xeb, 0xdb, 0x11, 0xcf, 0x19, 0x5c,
+	0x81, 0xc5, 0xbb, 0x43, 0xbf, 0xef, 0x89, 0x67, 0x31, 0x1c, 0x95, 0xbd, 0x6e, 0x19, 0x38, 0x9c,
+	0xa1, 0xb4, 0x13, 0xd0, 0x5d, 0x1e, 0xa8, 0x23, 0x32, 0x4a, 0xd6, 0xcc, 0xae, 0x10, 0xcd, 0x1e,
+	0x29, 0xbe, 0xdc, 0x26, 0xeb, 0x84, 0x92, 0xfd, 0x67, 0x15, 0xc8, 0xe5, 0x06, 0xd0, 0xd0, 0x6c,
+	0x64, 0xb1, 0x0a, 0x6c, 0x64, 0x51, 0x7b, 0x32, 0xae, 0x99, 0x05, 0x7d, 0x0a, 0xe6, 0xa2, 0x9e,
+	0x93, 0xc8, 0x4d, 0x59, 0x95, 0x2b, 0xbe, 0x47, 0x81, 0x0f, 0xcc, 0x14, 0x06, 0x83, 0x60, 0x4e,
+	0x6d, 0x9a, 0xa4, 0xf2, 0x29, 0x66, 0xfa, 0xcb, 0x3c, 0xff, 0x8b, 0x49, 0x32, 0xec, 0xa7, 0xc2,
+	0xe5, 0xdd, 0x2d, 0x6a, 0x65, 0x39, 0x57, 0x9d, 0x08, 0xe6, 0xcf, 0xd8, 0x90, 0x88, 0x3e, 0x0f,
+	0xf5, 0x24, 0x75, 0xe2, 0xf4, 0x31, 0x73, 0x49, 0x6a, 0xf9, 0xda, 0x92, 0x09, 0xd6, 0xfc, 0x68,
+	0x06, 0xa7, 0xe3, 0x07, 0x7e, 0xd2, 0x63, 0xdc, 0xab, 0x8f, 0x77, 0x05, 0x5d, 0x53, 0x1c, 0xb0,
+	0xc1, 0xcd, 0xfe, 0x05, 0xb8, 0x74, 0x5a, 0xe3, 0x1b, 0x75, 0x1c, 0xef, 0x39, 0x71, 0x20, 0xea,
+	0xe0, 0x4c, 0xcd, 0xee, 0x38, 0x71, 0x80, 0x19, 0xd4, 0xfe, 0x56, 0x09, 0x16, 0x8c, 0xde, 0xc6,
+	0x29, 0xec, 0x45, 0xae, 0x17, 0xb3, 0x34, 0x65, 0x2f, 0xe6, 0x0b, 0x50, 0x8b, 0x68, 0xda, 0xdd,
+	0x57, 0xe5, 0xad, 0x45, 0x16, 0x3d, 0x09, 0x18, 0x56, 0x58, 0x94, 0x42, 0xfd, 0x8d, 0x7b, 0x29,
+	0xb3, 0x8a, 0xb2, 0x98, 0x35, 0x4b, 0xcd, 0x46, 0x5a, 0x58, 0xbd, 0x4d, 0x12, 0x92, 0x60, 0x2d,
+	0x88, 0x66, 0x7e, 0xba, 0xb4, 0xcb, 0x91, 0xe7, 0x34, 0x45, 0xe6, 0x87, 0xf5, 0x3d, 0x26, 0x58,
+	0x60, 0xec, 0xef, 0x96, 0xa0, 0x8e, 0x49, 0x14, 0x6e, 0xc4, 0xc4, 0x4b, 0xd0, 0x87, 0xa0, 0x3c,
+	0x8c, 0xfb, 0x62, 0xa5, 0x16, 0x04, 0xf3, 0x32, 0x6d, 0xcd, 0xa1, 0xf0, 0x4c, 0x80, 0x59, 0x7a,
+	0xa4, 0x00, 0xb3, 0x7c, 0x6a, 0x80, 0x49, 0x63, 0xe7, 0xa4, 0xb7, 0x17, 0xfb, 0x87, 0x4e, 0x4a,
+	0x6e, 0x91, 0xa3, 0x46, 0x25, 0x17, 0x3b, 0xb7, 0x6f, 0x68, 0x24, 0xce, 0xd2, 0xa2, 0xeb, 0xb0,
+	0xa2, 0x23, 0x3d, 0x12, 0xa7, 0x9b, 0x34, 0x96, 0xe2, 0xc1, 0xb7, 0xaa, 0x4f, 0xe8, 0xd8, 0x50,
+	0x10, 0xe0, 0xd1, 0x77, 0xd0, 0x26, 0x9c, 0xcd, 0x00, 0xe9, 0x40, 0xe6, 0x19, 0x9f, 0x86, 0xe0,
+	0x73, 0x36, 0xc3, 0x87, 0x8e, 0x65, 0xe4, 0x0d, 0xfb, 0x3d, 0x0b, 0x96, 0xd4, 0xa2, 0x3e, 0x85,
+	0x18, 0xcf, 0xcf, 0xc6, 0x78, 0x9b, 0x33, 0xe5, 0xcc, 0xc4, 0xb0, 0x27, 0x44, 0x79, 0x7f, 0x34,
+	0x0f, 0x40, 0x69, 0x12, 0x9f, 0xe5, 0xce, 0x2f, 0x41, 0x25, 0x26, 0x51, 0x98, 0x3f, 0x5b, 0x94,
+	0x02, 0x33, 0xcc, 0xff, 0x5d, 0x9d, 0x19, 0x97, 0x3c, 0x9a, 0xfb, 0x11, 0x26, 0x8f, 0xda, 0x70,
+	0xde, 0x0f, 0x12, 0xda, 0xc1, 0x23, 0xaa, 0x6c, 0x37, 0xc2, 0x44, 0xe9, 0x5f, 0xad, 0xf5, 0x21,
+	0xc1, 0xe8, 0xfc, 0xd6, 0x38, 0x22, 0x3c, 0xfe, 0x5d, 0xba, 0x9e, 0x12, 0xc1, 0xec, 0x74, 0xcd,
+	0xf0, 0xc3, 0x04, 0x1c, 0x2b, 0x0a, 0xea, 0xdb, 0x90, 0xc0, 0xb9, 0xdb, 0x27, 0xdb, 0x9d, 0x84,
+	0x25, 0xe6, 0x6b, 0x86, 0x4b, 0xc6, 0x11, 0xd7, 0xda, 0x58, 0xd3, 0x8c, 0x3f, 0x77, 0xf5, 0x82,
+	0xce, 0x1d, 0x3c, 0xea, 0xb9, 0x53, 0xad, 0xa8, 0x0b, 0x13, 0x5b, 0x51, 0xe5, 0x5d, 0xb0, 0x38,
+	0xf1, 0x2e, 0xf8, 0x0c, 0x2c, 0xfb, 0x41, 0x8f, 0xc4, 0x7e, 0x4a, 0x3c, 0x76, 0x10, 0x1a, 0x4b,
+	0x6c, 0x21, 0x54, 0x17, 0xcd, 0x56, 0x06, 0x8b, 0x73, 0xd4, 0xf6, 0xd7, 0x4a, 0x70, 0x5e, 0x1f,
+	0x10, 0x3a, 0x32, 0xbf, 0x43, 0xb5, 0x84, 0xf5, 0x5c, 0xf0, 0x8c, 0x9f, 0xf1, 0x85, 0x8b, 0xaa,
+	0x0a, 0xb5, 0x15, 0x06, 0x1b, 0x54, 0x74, 0xff, 0x5c, 0x12, 0xb3, 0xd4, 0x71, 0xfe, 0xf4, 0x6c,
+	0x08, 0x38, 0x56, 0x14, 0xec, 0x23, 0x1a, 0x12, 0xa7, 0xed, 0xe1, 0x5d, 0xf6, 0x42, 0x2e, 0x49,
+	0xb7, 0xa1, 0x51, 0xd8, 0xa4, 0xa3, 0xf7, 0x98, 0x2b, 0x37, 0x8f, 0x9e, 0xa0, 0x45, 0x7e, 0x8f,
+	0xa9, 0xfd, 0x52, 0x58, 0x39, 0x1c, 0x1a, 0x34, 0x34, 0xe6, 0x46, 0x87, 0x43, 0xe1, 0x58, 0x51,
+	0xd8, 0xff, 0x65, 0xc1, 0x07, 0xc7, 0x2e, 0xc5, 0x53, 0x30, 0x89, 0xc3, 0xac, 0x49, 0xdc, 0x9b,
+	0xd1, 0x24, 0x8e, 0x4c, 0x61, 0x82, 0x79, 0xfc, 0x47, 0x0b, 0x96, 0x35, 0xfd, 0x53, 0x98, 0x67,
+	0xa7, 0xb8, 0xcf, 0x70, 0xf4, 0xb8, 0x5b, 0xf5, 0x91, 0x89, 0xbd, 0xc7, 0x26, 0xc6, 0xfd, 0xb1,
+	0x75, 0x57, 0x36, 0x7e, 0x9f, 0xe2, 0x57, 0xd1, 0x76, 0x4b, 0x1a, 0x38, 0xc9, 0xd1, 0xed, 0x16,
+	0x50, 0xcc, 0xe1, 0xc2, 0x59, 0x3c, 0xa6, 0x53, 0x07, 0xec, 0x31, 0xc1, 0x42, 0x1a, 0x55, 0x53,
+	0xcf, 0x4f, 0xa8, 0x91, 0xf2, 0x44, 0x78, 0xa7, 0x96, 0x70, 0x53, 0xc0, 0xb1, 0xa2, 0xb0, 0x07,
+	0xd0, 0xc8, 0x32, 0xdf, 0x24, 0xd4, 0x1f, 0x9d, 0x72, 0x8e, 0x6b, 0x50, 0x77, 0xd8, 0x5b, 0xdb,
+	0x43, 0x27, 0xdf, 0xfb, 0xbd, 0x2e, 0x11, 0x58, 0xd3, 0xd8, 0x7f, 0x6e, 0xc1, 0xb9, 0x31, 0x93,
+	0x29, 0x30, 0xac, 0x4d, 0xf5, 0xe1, 0x9f, 0xd0, 0x8e, 0xef, 0x91, 0x8e, 0x23, 0xe3, 0x12, 0x23,
+	0x8a, 0xd9, 0xe4, 0x60, 0x2c, 0xf1, 0xf6, 0x7f, 0x58, 0x70, 0x26, 0x3b, 0xd6, 0x04, 0xdd, 0x04,
+	0xc4, 0x27, 0xb3, 0xe9, 0x27, 0x6e, 0x78, 0x48, 0xe2, 0x23, 0x3a, 0x73, 0x3e, 0xea, 0x0b, 0x82,
+	0x13, 0x5a, 0x1f, 0xa1, 0xc0, 0x63, 0xde, 0x42, 0x5f, 0x67, 0xe9, 0x55, 0xb9, 0xda, 0x52, 0x4d,
+	0xda, 0x85, 0xa9, 0x89, 0xde, 0x49, 0xd3, 0x9d, 0x57, 0xf2, 0xb0, 0x29, 0xdc, 0xfe, 0x41, 0x19,
+	0x16, 0xe5, 0xeb, 0xb4, 0x67, 0x85, 0xae, 0x37, 0xf3, 0x92, 0x1b, 0x56, 0x76, 0xbd, 0x99, 0x0b,
+	0x8d, 0x39, 0x8e, 0xae, 0xf7, 0x81, 0x1f, 0x78, 0xf9, 0xf0, 0x9e, 0x7e, 0x59, 0x84, 0x19, 0x26,
+	0xfb, 0x75, 0x40, 0xf9, 0xf4, 0xaf, 0x03, 0x94, 0x26, 0x54, 0x1e, 0x16, 0xb0, 0xf0, 0x7e, 0x76,
+	0xed, 0xb6, 0x18, 0x86, 0x7e, 0x5f, 0xa3, 0xb0, 0x49, 0x47, 0x47, 0xd2, 0xf7, 0x0f, 0x09, 0x7f,
+	0x69, 0x3e, 0x3b, 0x92, 0x6d, 0x89, 0xc0, 0x9a, 0x86, 0x8e, 0xc4, 0xf3, 0x3b, 0x9d, 0x46, 0x35,
+	0x3b, 0x12, 0xba, 0x3a, 0x98, 0x61, 0x28, 0x45, 0x2f, 0x0c, 0x0f, 0x84, 0xb7, 0xa0, 0x28, 0x6e,
+	0x84, 0xe1, 0x01, 0x66, 0x18, 0xb4, 0x03, 0xe7, 0x82, 0x30, 0x1e, 0x38, 0x7d, 0xff, 0x2d, 0xe2,
+	0x29, 0x29, 0xc2, 0x4b, 0xf8, 0x09, 0xf1, 0xc2, 0xb9, 0xdd, 0x51, 0x12, 0x3c, 0xee, 0x3d, 0xaa,
+	0x7e, 0x51, 0x4c, 0x3c, 0xdf, 0x4d, 0x4d, 0x6e, 0x90, 0x55, 0xbf, 0xbd, 0x11, 0x0a, 0x3c, 0xe6,
+	0x2d, 0xfb, 0x3f, 0xd9, 0x05, 0x35, 0xa1, 0xb3, 0xa9, 0xa8, 0xed, 0x97, 0xbb, 0x59, 0x7e, 0x98,
+	0x09, 0xd1, 0x0a, 0x52, 0x99, 0x42, 0x41, 0x5e, 0x86, 0x45, 0xda, 0x6a, 0xbd, 0x17, 0xfa, 0x81,
+	0xea, 0x1a, 0x16, 0x8d, 0x00, 0x37, 0xdb, 0xb7, 0x77, 0x25, 0x1c, 0x67, 0xa8, 0xec, 0x77, 0xe6,
+	0xe0, 0x39, 0x55, 0x12, 0x27, 0xe9, 0xbd, 0x30, 0x3e, 0xf0, 0x83, 0x2e, 0xcb, 0x27, 0x7e, 0xd3,
+	0x82, 0x45, 0xae, 0x28, 0xa2, 0xe1, 0x92, 0xd7, 0xfc, 0xdd, 0x22, 0x8a, 0xef, 0x19, 0x49, 0xcd,
+	0x7d, 0x43, 0x4a, 0xae, 0xd9, 0xd2, 0x44, 0xe1, 0xcc, 0x70, 0xd0, 0x5b, 0x00, 0xf2, 0xfb, 0x8d,
+	0x4e, 0x11, 0x9f, 0xb0, 0xc8, 0xc1, 0x61, 0xd2, 0xd1, 0x2e, 0xd8, 0xbe, 0x92, 0x80, 0x0d, 0x69,
+	0xb4, 0x6d, 0x66, 0xbe, 0xcf, 0x57, 0xa5, 0xcc, 0x04, 0xff, 0x62, 0xf1, 0xab, 0x62, 0xae, 0x87,
+	0xba, 0xd4, 0xc4, 0x4a, 0x08, 0xe1, 0x08, 0x43, 0xd5, 0x0f, 0xba, 0x31, 0x49, 0x64, 0x06, 0xe1,
+	0xa3, 0x86, 0x1b, 0xd1, 0x74, 0xc3, 0x98, 0x30, 0xa7, 0x21, 0x74, 0xbc, 0x96, 0xd3, 0x77, 0x02,
+	0x97, 0xc4, 0x5b, 0x9c, 0x5c, 0xdb, 0x77, 0x01, 0xc0, 0x92, 0xd1, 0x48, 0x47, 0xc9, 0xdc, 0x34,
+	0x1d, 0x25, 0xb4, 0xf5, 0x75, 0x64, 0x1b, 0x1f, 0xa5, 0xf5, 0xf5, 0xc2, 0xa7, 0x61, 0xe1, 0x31,
+	0x5f, 0xb5, 0xbf, 0x37, 0xa7, 0x8d, 0x34, 0x6d, 0xd9, 0xa0, 0xad, 0x14, 0xb1, 0xde, 0x4d, 0xe1,
+	0x61, 0x15, 0xa5, 0x1b, 0x46, 0xaf, 0xbf, 0x02, 0x62, 0x53, 0x1e, 0xd5, 0xcc, 0xc8, 0x89, 0x49,
+	0xf0, 0x44, 0x35, 0x73, 0x4f, 0x49, 0xc0, 0x86, 0x34, 0x44, 0x44, 0x33, 0x65, 0x79, 0xe6, 0x84,
+	0x92, 0xac, 0x02, 0x8c, 0x6d, 0xa8, 0x7c, 0xdb, 0x82, 0xe5, 0x20, 0xa3, 0xaf, 0x8d, 0xca, 0xcc,
+	0x65, 0xd0, 0xf1, 0x07, 0x81, 0xf7, 0x8f, 0x65, 0x61, 0x38, 0x27, 0x1c, 0xad, 0xc3, 0x19, 0xb9,
+	0x03, 0xd9, 0x3e, 0x0b, 0x15, 0x6b, 0xe3, 0x2c, 0x1a, 0xe7, 0xe9, 0x8d, 0x9e, 0xa8, 0xf9, 0x49,
+	0x3d, 0x51, 0xe8, 0x40, 0xb5, 0x3f, 0x56, 0x8b, 0x6d, 0x7f, 0x84, 0xd1, 0xd6, 0x47, 0xfb, 0xaf,
+	0x2d, 0x38, 0x2b, 0x47, 0x7d, 0xfb, 0x90, 0xc4, 0xb1, 0xef, 0xb1, 0x7b, 0x81, 0xa3, 0xb5, 0x83,
+	0xa5, 0xee, 0x85, 0x1b, 0x12, 0x81, 0x35, 0x0d, 0xf5, 0xec, 0xb8, 0x93, 0x95, 0xe4, 0xf3, 0xd3,
+	0xc2, 0x79, 0xc3, 0x12, 0x4f, 0x23, 0xf7, 0xd1, 0x3e, 0xe1, 0x52, 0x36, 0x72, 0x9f, 0xa6, 0xa3,
+	0xd7, 0xfe, 0xa1, 0x05, 0xe6, 0xe9, 0x98, 0xee, 0xd6, 0xfc, 0x18, 0x54, 0x0f, 0xc5, 0xd6, 0xe5,
+	0x6a, 0x7b, 0x72, 0xcb, 0x24, 0x5e, 0x5d, 0xb0, 0xe5, 0xe9, 0xfc, 0xab, 0xca, 0x23, 0xf8, 0x57,
+	0x73, 0x13, 0x6f, 0x64, 0x9a, 0x07, 0xf5, 0xbd, 0xc6, 0x7c, 0x2e, 0x0f, 0xba, 0xb5, 0x89, 0x29,
+	0xdc, 0xfe, 0x61, 0x59, 0x07, 0x43, 0x22, 0xdf, 0xfe, 0x63, 0x31, 0xed, 0x97, 0x55, 0x69, 0x96,
+	0xcf, 0xfc, 0xf9, 0x6c, 0x69, 0xf6, 0xc1, 0xf1, 0x2a, 0xf0, 0xe9, 0xb2, 0x22, 0xd9, 0x98, 0x42,
+	0x6d, 0xf5, 0x94, 0xaa, 0xc8, 0x15, 0xa8, 0x51, 0x9f, 0x90, 0x65, 0x27, 0x6a, 0x19, 0x11, 0xb5,
+	0x1b, 0x02, 0xfe, 0xc0, 0xf8, 0x8d, 0x15, 0x35, 0x5a, 0x87, 0x3a, 0xfd, 0xcd, 0xca, 0x31, 0xc2,
+	0x77, 0xbc, 0xac, 0xce, 0x82, 0x44, 0x8c, 0xa9, 0xdc, 0xe8, 0xb7, 0xd0, 0xcf, 0x43, 0x9d, 0x75,
+	0xca, 0x33, 0x16, 0xdc, 0x61, 0xbc, 0xa8, 0xca, 0x1c, 0x12, 0xf1, 0xc0, 0x7c, 0xc0, 0xfa, 0x05,
+	0xfb, 0x7d, 0x63, 0xcf, 0x45, 0x25, 0xfb, 0xc7, 0x62, 0xcf, 0xaf, 0xe4, 0xf6, 0xfc, 0xd2, 0xc8,
+	0x9e, 0x2f, 0xeb, 0x3e, 0xf1, 0xcc, 0xbe, 0x3f, 0x4d, 0x03, 0x39, 0x45, 0x9c, 0xc1, 0xae, 0x85,
+	0x37, 0x87, 0x7e, 0x4c, 0x92, 0xbd, 0x78, 0x18, 0xd0, 0xb2, 0x7a, 0x9d, 0x11, 0x1b, 0xd7, 0x42,
+	0x06, 0x8d, 0xf3, 0xf4, 0xf6, 0x5f, 0x96, 0xe0, 0x4c, 0xae, 0x6f, 0x9c, 0xe6, 0x12, 0xe4, 0x87,
+	0x01, 0xf9, 0x0c, 0x9c, 0x24, 0xc5, 0x8a, 0x02, 0x7d, 0x11, 0xc0, 0x23, 0x51, 0x3f, 0x3c, 0x62,
+	0x95, 0xb1, 0xca, 0x23, 0x57, 0xc6, 0xd4, 0x95, 0xbf, 0xa9, 0xb8, 0x60, 0x83, 0x23, 0xba, 0x00,
+	0x25, 0xdf, 0x63, 0xbb, 0x59, 0x6e, 0x81, 0xa0, 0x2d, 0x6d, 0x6d, 0xe2, 0x92, 0xef, 0x19, 0x1d,
+	0x52, 0xf3, 0x4f, 0xaf, 0x43, 0xca, 0xfe, 0x07, 0x76, 0x73, 0xf1, 0xe9, 0xef, 0xc8, 0xac, 0xd4,
+	0x47, 0x60, 0xde, 0x19, 0xa6, 0xbd, 0x70, 0xa4, 0xa9, 0x74, 0x9d, 0x41, 0xb1, 0xc0, 0xa2, 0x6d,
+	0xa8, 0x78, 0x34, 0x7c, 0x2b, 0x3d, 0xf2, 0x42, 0xe9, 0x58, 0x94, 0x06, 0x77, 0x8c, 0x0b, 0x2d,
+	0x0b, 0xa6, 0x4e, 0x57, 0xd6, 0xe2, 0x58, 0x59, 0x70, 0xdf, 0xa1, 0xfd, 0x64, 0x14, 0x6a, 0x9a,
+	0xa9, 0xca, 0x29, 0xfd, 0x24, 0x7f, 0x51, 0x81, 0xa5, 0x4c, 0xc1, 0x35, 0xa3, 0x05, 0xd6, 0xa9,
+	0x5a, 0x70, 0x19, 0xe6, 0xa2, 0x78, 0x18, 0xf0, 0x79, 0xd5, 0xb4, 0x61, 0xa0, 0x7a, 0x46, 0x8b,
+	0xc9, 0xf4, 0x0f, 0x5d, 0x23, 0x2f, 0x3e, 0xc2, 0xc3, 0x40, 0xa4, 0xa8, 0xd4, 0x1a, 0x6d, 0x32,
+	0x28, 0x16, 0x58, 0xf4, 0x25, 0x58, 0x4c, 0xd8, 0x01, 0x8c, 0x9d, 0x94, 0x74, 0xe5, 0xb7, 0x44,
+	0xd7, 0x67, 0xfe, 0xee, 0x83, 0xb3, 0xe3, 0xce, 0xbe, 0x09, 0xc1, 0x19, 0x71, 0xb4, 0x63, 0xd2,
+	0xf8, 0xd6, 0x65, 0x7e, 0xe6, 0x6c, 0x6a, 0xbe, 0x90, 0xcd, 0xb5, 0xeb, 0xe1, 0x9f, 0xbc, 0x44,
+	0x4a, 0xb3, 0xab, 0x4f, 0x40, 0xb3, 0x61, 0x4c, 0xdf, 0xdf, 0xc7, 0xa1, 0x3e, 0x70, 0x02, 0xbf,
+	0x43, 0x92, 0x94, 0xff, 0xbb, 0x94, 0x3a, 0xff, 0xaa, 0x7c, 0x47, 0x02, 0xb1, 0xc6, 0xdb, 0x5f,
+	0xb1, 0xe0, 0xfc, 0xd8, 0x69, 0x3d, 0xb5, 0x14, 0x02, 0xb5, 0x5c, 0xe7, 0xc6, 0xb4, 0x08, 0xa0,
+	0xc3, 0x27, 0xf3, 0xa1, 0x12, 0xe7, 0xce, 0x97, 0x64, 0xec, 0x8e, 0x3d, 0x9a, 0xd5, 0xd4, 0x96,
+	0xab, 0xfc, 0x14, 0x2d, 0xd7, 0x6f, 0x5b, 0x60, 0x7c, 0x46, 0x87, 0x7e, 0x19, 0xea, 0xce, 0x30,
+	0x0d, 0x07, 0x4e, 0x4a, 0x3c, 0x11, 0x46, 0xee, 0x16, 0xf2, 0xc1, 0xde, 0xba, 0xe4, 0xca, 0xd7,
+	0x4b, 0x3d, 0x62, 0x2d, 0xcf, 0xee, 0xc1, 0xb9, 0x31, 0x2f, 0x68, 0x43, 0x62, 0x3d, 0xc4, 0x90,
+	0x7c, 0x02, 0x6a, 0x09, 0xe9, 0x77, 0xe8, 0x85, 0x29, 0x0c, 0x8e, 0x5a, 0xeb, 0xb6, 0x80, 0x63,
+	0x45, 0x61, 0xff, 0x40, 0xcc, 0x5a, 0xf8, 0x30, 0x57, 0x72, 0xdd, 0x78, 0xd3, 0x5f, 0xff, 0x47,
+	0xf4, 0xab, 0x29, 0xd9, 0x9e, 0x5b, 0xc0, 0xd7, 0x68, 0xba, 0xd7, 0xd7, 0xfc, 0x56, 0x4a, 0xc2,
+	0xb0, 0x21, 0x2c, 0xa3, 0x5d, 0xe5, 0xd3, 0xb4, 0xcb, 0xfe, 0x77, 0x0b, 0x32, 0x06, 0x0e, 0x0d,
+	0x60, 0x8e, 0x8e, 0xe0, 0xa8, 0x80, 0x4e, 0x62, 0x93, 0x2f, 0xd5, 0x3c, 0x51, 0x3a, 0x61, 0x3f,
+	0x31, 0x97, 0x82, 0x7c, 0xe1, 0xba, 0xf0, 0x25, 0xba, 0x55, 0x90, 0x34, 0xea, 0xf9, 0xb4, 0x6a,
+	0x59, 0x1f, 0xc8, 0xbe, 0x02, 0x2b, 0x23, 0x23, 0xa2, 0x4a, 0xc4, 0x7a, 0x08, 0xf3, 0x4a, 0xc4,
+	0xba, 0x0c, 0x31, 0xc7, 0xd9, 0xdf, 0xb2, 0xe0, 0x6c, 0x9e, 0x3d, 0xfa, 0x03, 0x0b, 0x56, 0x92,
+	0x3c, 0xbf, 0x27, 0xb2, 0x6a, 0x2a, 0xe6, 0x1c, 0x41, 0xe1, 0xd1, 0x11, 0xd8, 0x7f, 0x5f, 0xe2,
+	0x3a, 0xcc, 0xff, 0xc9, 0x94, 0x32, 0xa0, 0xd6, 0x44, 0x03, 0x4a, 0x8f, 0x88, 0xdb, 0x23, 0xde,
+	0xb0, 0x3f, 0x52, 0x46, 0x6d, 0x0b, 0x38, 0x56, 0x14, 0x94, 0xda, 0x1b, 0x8a, 0x9e, 0xb4, 0x9c,
+	0x7a, 0x6d, 0x0a, 0x38, 0x56, 0x14, 0x34, 0x87, 0x66, 0x4c, 0x92, 0x27, 0xe7, 0x44, 0x0e, 0xcd,
+	0xb0, 0x45, 0x09, 0xce, 0x50, 0xe5, 0xbe, 0xf6, 0x98, 0x3b, 0xed, 0x6b, 0x0f, 0x56, 0xa3, 0xe5,
+	0xed, 0xf7, 0x32, 0x67, 0xc1, 0x6b, 0xb4, 0x02, 0x86, 0x15, 0x96, 0x96, 0x99, 0x07, 0x4e, 0x30,
+	0x74, 0xfa, 0x74, 0x85, 0x44, 0xd1, 0x5f, 0x1d, 0xa8, 0x1d, 0x85, 0xc1, 0x06, 0x15, 0x3d, 0x22,
+	0xf9, 0x6f, 0x27, 0x32, 0xad, 0x03, 0xd6, 0xa9, 0xad, 0x03, 0xd9, 0xe2, 0x76, 0x69, 0xaa, 0xe2,
+	0xb6, 0x59, 0x77, 0x2e, 0x3f, 0xb4, 0xee, 0xfc, 0x61, 0xa8, 0x1e, 0x90, 0x23, 0xa3, 0x40, 0xcd,
+	0xff, 0xc7, 0x0c, 0x07, 0x61, 0x89, 0xa3, 0x69, 0x1d, 0xd7, 0x51, 0xbd, 0x3f, 0x8b, 0xfc, 0x66,
+	0xdf, 0x58, 0x67, 0x44, 0x02, 0xd3, 0x6a, 0xbe, 0xfb, 0xfe, 0xc5, 0x67, 0xbe, 0xf3, 0xfe, 0xc5,
+	0x67, 0xde, 0x7b, 0xff, 0xe2, 0x33, 0x5f, 0x39, 0xb9, 0x68, 0xbd, 0x7b, 0x72, 0xd1, 0xfa, 0xce,
+	0xc9, 0x45, 0xeb, 0xbd, 0x93, 0x8b, 0xd6, 0xbf, 0x9d, 0x5c, 0xb4, 0x7e, 0xef, 0xfb, 0x17, 0x9f,
+	0xf9, 0x5c, 0x4d, 0xea, 0xea, 0xff, 0x0e, 0x00, 0x4c, 0xe8, 0x33, 0x2f, 0x22, 0x54, 0x00, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -19484,7 +19482,7 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncPhase = string(dAtA[iNdEx:postIndex])
+			m.SyncPhase = SyncPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
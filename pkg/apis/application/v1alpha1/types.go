@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -11,6 +12,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/robfig/cron"
@@ -588,6 +590,19 @@ func NewHookType(t string) (HookType, bool) {
 
 }
 
+// ErrInvalidHookType is wrapped by the error returned by ParseHookType if the hook type is not valid
+var ErrInvalidHookType = fmt.Errorf("invalid hook type")
+
+// ParseHookType returns the hook type with the given name or an error listing valid hook types
+func ParseHookType(t string) (HookType, error) {
+	hookType, ok := NewHookType(t)
+	if !ok {
+		return "", fmt.Errorf("%w '%s', valid values are: %s, %s, %s, %s, %s", ErrInvalidHookType, t,
+			HookTypePreSync, HookTypeSync, HookTypePostSync, HookTypeSyncFail, HookTypeSkip)
+	}
+	return hookType, nil
+}
+
 type HookDeletePolicy string
 
 const (
@@ -603,6 +618,41 @@ func NewHookDeletePolicy(p string) (HookDeletePolicy, bool) {
 			p == string(HookDeletePolicyBeforeHookCreation)
 }
 
+// ErrInvalidHookDeletePolicy is wrapped by the error returned by ParseHookDeletePolicy if the policy is not valid
+var ErrInvalidHookDeletePolicy = fmt.Errorf("invalid hook delete policy")
+
+// ParseHookDeletePolicy returns the hook delete policy with the given name or an error listing valid policies
+func ParseHookDeletePolicy(p string) (HookDeletePolicy, error) {
+	policy, ok := NewHookDeletePolicy(p)
+	if !ok {
+		return "", fmt.Errorf("%w '%s', valid values are: %s, %s, %s", ErrInvalidHookDeletePolicy, p,
+			HookDeletePolicyHookSucceeded, HookDeletePolicyHookFailed, HookDeletePolicyBeforeHookCreation)
+	}
+	return policy, nil
+}
+
+// ParseHookDeletePolicies parses the comma separated list of hook delete policies, e.g. the value of the hook delete
+// policy annotation. Duplicate policies are returned once, in the order of the first occurrence.
+func ParseHookDeletePolicies(s string) ([]HookDeletePolicy, error) {
+	var policies []HookDeletePolicy
+	seen := make(map[HookDeletePolicy]bool)
+	for _, token := range strings.Split(s, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+		policy, err := ParseHookDeletePolicy(token)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[policy] {
+			seen[policy] = true
+			policies = append(policies, policy)
+		}
+	}
+	return policies, nil
+}
+
 // data about a specific revision within a repo
 type RevisionMetadata struct {
 	// who authored this revision,
@@ -637,17 +687,48 @@ const (
 	ResultCodeSyncFailed   ResultCode = "SyncFailed"
 	ResultCodePruned       ResultCode = "Pruned"
 	ResultCodePruneSkipped ResultCode = "PruneSkipped"
+	// ResultCodeSyncFailedConflict means the resource was not applied because of the field ownership conflict. Unlike
+	// ResultCodeSyncFailed the failure is transient and the sync might be retried with force.
+	ResultCodeSyncFailedConflict ResultCode = "SyncFailedConflict"
 )
 
-type SyncPhase = string
+type SyncPhase string
 
 const (
-	SyncPhasePreSync  = "PreSync"
-	SyncPhaseSync     = "Sync"
-	SyncPhasePostSync = "PostSync"
-	SyncPhaseSyncFail = "SyncFail"
+	SyncPhasePreSync  SyncPhase = "PreSync"
+	SyncPhaseSync     SyncPhase = "Sync"
+	SyncPhasePostSync SyncPhase = "PostSync"
+	SyncPhaseSyncFail SyncPhase = "SyncFail"
 )
 
+// NewSyncPhase converts the given string into a sync phase and returns false if it is not one of the known phases
+func NewSyncPhase(p string) (SyncPhase, bool) {
+	return SyncPhase(p),
+		p == string(SyncPhasePreSync) ||
+			p == string(SyncPhaseSync) ||
+			p == string(SyncPhasePostSync) ||
+			p == string(SyncPhaseSyncFail)
+}
+
+// Order returns the position of the phase in the sync: PreSync=0, Sync=1, PostSync=2, SyncFail=3. Empty or unknown
+// phase is ordered as Sync, which is the default phase of resources.
+func (p SyncPhase) Order() int {
+	switch p {
+	case SyncPhasePreSync:
+		return 0
+	case SyncPhasePostSync:
+		return 2
+	case SyncPhaseSyncFail:
+		return 3
+	}
+	return 1
+}
+
+// IsFailPhase returns true if the phase is executed only if the sync fails
+func (p SyncPhase) IsFailPhase() bool {
+	return p == SyncPhaseSyncFail
+}
+
 // ResourceResult holds the operation result details of a specific resource
 type ResourceResult struct {
 	Group     string `json:"group" protobuf:"bytes,1,opt,name=group"`
@@ -668,6 +749,11 @@ type ResourceResult struct {
 	SyncPhase SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,10,opt,name=syncPhase"`
 }
 
+// IsConflict returns true if the resource failed to sync because of the field ownership conflict
+func (r *ResourceResult) IsConflict() bool {
+	return r.Status == ResultCodeSyncFailedConflict
+}
+
 func (r *ResourceResult) GroupVersionKind() schema.GroupVersionKind {
 	return schema.GroupVersionKind{
 		Group:   r.Group,
@@ -705,6 +791,36 @@ func (r ResourceResults) PruningRequired() (num int) {
 	return num
 }
 
+// FormatResultsTable returns the given sync results formatted as a table with aligned columns. Results are ordered by
+// sync phase and then by the resource key, so sync outcomes are rendered consistently.
+func FormatResultsTable(results ResourceResults) string {
+	sorted := make(ResourceResults, len(results))
+	copy(sorted, results)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		phase1, phase2 := sorted[i].SyncPhase.Order(), sorted[j].SyncPhase.Order()
+		if phase1 != phase2 {
+			return phase1 < phase2
+		}
+		key1 := fmt.Sprintf("%s/%s/%s/%s", sorted[i].Group, sorted[i].Kind, sorted[i].Namespace, sorted[i].Name)
+		key2 := fmt.Sprintf("%s/%s/%s/%s", sorted[j].Group, sorted[j].Kind, sorted[j].Namespace, sorted[j].Name)
+		return key1 < key2
+	})
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "RESOURCE\tPHASE\tSTATUS\tHOOK\tMESSAGE\n")
+	for _, res := range sorted {
+		status := string(res.Status)
+		if status == "" {
+			// hooks have no result code, so report the hook operation phase instead
+			status = string(res.HookPhase)
+		}
+		_, _ = fmt.Fprintf(w, "%s/%s\t%s\t%s\t%s\t%s\n", res.Kind, res.Name, res.SyncPhase, status, res.HookType, res.Message)
+	}
+	_ = w.Flush()
+	return buf.String()
+}
+
 // RevisionHistory contains information relevant to an application deployment
 type RevisionHistory struct {
 	Revision   string            `json:"revision" protobuf:"bytes,2,opt,name=revision"`
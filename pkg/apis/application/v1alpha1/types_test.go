@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -669,6 +670,40 @@ func TestNewHookDeletePolicy(t *testing.T) {
 	})
 }
 
+func TestParseHookType(t *testing.T) {
+	hookType, err := ParseHookType("PostSync")
+	assert.NoError(t, err)
+	assert.Equal(t, HookTypePostSync, hookType)
+
+	_, err = ParseHookType("Garbage")
+	assert.True(t, errors.Is(err, ErrInvalidHookType))
+	assert.EqualError(t, err, "invalid hook type 'Garbage', valid values are: PreSync, Sync, PostSync, SyncFail, Skip")
+}
+
+func TestParseHookDeletePolicy(t *testing.T) {
+	p, err := ParseHookDeletePolicy("HookFailed")
+	assert.NoError(t, err)
+	assert.Equal(t, HookDeletePolicyHookFailed, p)
+
+	_, err = ParseHookDeletePolicy("Garbage")
+	assert.True(t, errors.Is(err, ErrInvalidHookDeletePolicy))
+	assert.EqualError(t, err, "invalid hook delete policy 'Garbage', valid values are: HookSucceeded, HookFailed, BeforeHookCreation")
+}
+
+func TestParseHookDeletePolicies(t *testing.T) {
+	policies, err := ParseHookDeletePolicies(" HookSucceeded, BeforeHookCreation,HookSucceeded")
+	assert.NoError(t, err)
+	assert.Equal(t, []HookDeletePolicy{HookDeletePolicyHookSucceeded, HookDeletePolicyBeforeHookCreation}, policies)
+
+	policies, err = ParseHookDeletePolicies("")
+	assert.NoError(t, err)
+	assert.Empty(t, policies)
+
+	_, err = ParseHookDeletePolicies("HookSucceeded,Garbage")
+	assert.True(t, errors.Is(err, ErrInvalidHookDeletePolicy))
+	assert.Contains(t, err.Error(), "'Garbage'")
+}
+
 func TestSyncStrategy_Force(t *testing.T) {
 	type fields struct {
 		Apply *SyncStrategyApply
@@ -784,6 +819,36 @@ func TestResourceResults_Find(t *testing.T) {
 	}
 }
 
+func TestNewSyncPhase(t *testing.T) {
+	for _, phase := range []SyncPhase{SyncPhasePreSync, SyncPhaseSync, SyncPhasePostSync, SyncPhaseSyncFail} {
+		p, ok := NewSyncPhase(string(phase))
+		assert.True(t, ok)
+		assert.Equal(t, phase, p)
+	}
+	_, ok := NewSyncPhase("Skip")
+	assert.False(t, ok)
+}
+
+func TestSyncPhase_Order(t *testing.T) {
+	assert.Equal(t, 0, SyncPhasePreSync.Order())
+	assert.Equal(t, 1, SyncPhaseSync.Order())
+	assert.Equal(t, 2, SyncPhasePostSync.Order())
+	assert.Equal(t, 3, SyncPhaseSyncFail.Order())
+	assert.Equal(t, 1, SyncPhase("").Order())
+}
+
+func TestSyncPhase_IsFailPhase(t *testing.T) {
+	assert.True(t, SyncPhaseSyncFail.IsFailPhase())
+	assert.False(t, SyncPhaseSync.IsFailPhase())
+	assert.False(t, SyncPhasePostSync.IsFailPhase())
+}
+
+func TestResourceResult_IsConflict(t *testing.T) {
+	assert.True(t, (&ResourceResult{Status: ResultCodeSyncFailedConflict}).IsConflict())
+	assert.False(t, (&ResourceResult{Status: ResultCodeSyncFailed}).IsConflict())
+	assert.False(t, (&ResourceResult{Status: ResultCodeSynced}).IsConflict())
+}
+
 func TestResourceResults_PruningRequired(t *testing.T) {
 	needsPruning := &ResourceResult{Status: ResultCodePruneSkipped}
 	tests := []struct {
@@ -804,6 +869,21 @@ func TestResourceResults_PruningRequired(t *testing.T) {
 	}
 }
 
+func TestFormatResultsTable(t *testing.T) {
+	results := ResourceResults{
+		{Kind: "Job", Name: "after", SyncPhase: SyncPhasePostSync, HookType: HookTypePostSync, HookPhase: OperationSucceeded},
+		{Kind: "Service", Name: "guestbook", SyncPhase: SyncPhaseSync, Status: ResultCodeSynced, Message: "service/guestbook created"},
+		{Group: "apps", Kind: "Deployment", Name: "guestbook", SyncPhase: SyncPhaseSync, Status: ResultCodeSynced},
+		{Kind: "Job", Name: "before", SyncPhase: SyncPhasePreSync, HookType: HookTypePreSync, HookPhase: OperationSucceeded},
+	}
+	assert.Equal(t, `RESOURCE              PHASE     STATUS     HOOK      MESSAGE
+Job/before            PreSync   Succeeded  PreSync   
+Service/guestbook     Sync      Synced               service/guestbook created
+Deployment/guestbook  Sync      Synced               
+Job/after             PostSync  Succeeded  PostSync  
+`, FormatResultsTable(results))
+}
+
 func TestApplicationSource_IsZero(t *testing.T) {
 	tests := []struct {
 		name   string
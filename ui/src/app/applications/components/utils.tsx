@@ -196,6 +196,7 @@ export const ResourceResultIcon = ({resource}: {resource: appModels.ResourceResu
                 icon = 'fa-heart';
                 break;
             case appModels.ResultCodes.SyncFailed:
+            case appModels.ResultCodes.SyncFailedConflict:
                 color = COLORS.sync_result.failed;
                 icon = 'fa-heart-broken';
                 break;
@@ -82,13 +82,14 @@ export interface SyncOperationResult {
     revision: string;
 }
 
-export type ResultCode = 'Synced' | 'SyncFailed' | 'Pruned' | 'PruneSkipped';
+export type ResultCode = 'Synced' | 'SyncFailed' | 'Pruned' | 'PruneSkipped' | 'SyncFailedConflict';
 
 export const ResultCodes = {
     Synced: 'Synced',
     SyncFailed: 'SyncFailed',
     Pruned: 'Pruned',
-    PruneSkipped: 'PruneSkipped'
+    PruneSkipped: 'PruneSkipped',
+    SyncFailedConflict: 'SyncFailedConflict'
 };
 
 export interface ResourceResult {
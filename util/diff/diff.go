@@ -230,6 +230,19 @@ func threeWayMergePatch(orig, config, live *unstructured.Unstructured) ([]byte,
 	}
 }
 
+// LiveTargetPatch returns the strategic merge patch (or JSON merge patch for types unknown to the scheme) which
+// reconciles the live object with the config. The last-applied-configuration of the live object is used as the
+// original state, so fields removed from the config are removed from the live object as well.
+func LiveTargetPatch(config, live *unstructured.Unstructured) ([]byte, error) {
+	orig := GetLastAppliedConfigAnnotation(live)
+	if orig == nil {
+		orig = &unstructured.Unstructured{Object: map[string]interface{}{}}
+		orig.SetGroupVersionKind(config.GroupVersionKind())
+	}
+	patch, _, err := threeWayMergePatch(orig, config, live)
+	return patch, err
+}
+
 func GetLastAppliedConfigAnnotation(live *unstructured.Unstructured) *unstructured.Unstructured {
 	if live == nil {
 		return nil
@@ -347,6 +347,28 @@ func TestThreeWayDiffExplicitNamespace(t *testing.T) {
 	log.Println(ascii)
 }
 
+func TestLiveTargetPatch(t *testing.T) {
+	configDep := test.DemoDeployment()
+	configDep.Annotations = map[string]string{"foo": "bar"}
+	configBytes, err := json.Marshal(configDep)
+	assert.Nil(t, err)
+	liveDep := configDep.DeepCopy()
+	liveDep.Annotations[v1.LastAppliedConfigAnnotation] = string(configBytes)
+
+	patch, err := LiveTargetPatch(mustToUnstructured(configDep), mustToUnstructured(liveDep))
+	assert.Nil(t, err)
+	assert.Equal(t, "{}", string(patch))
+
+	// annotation removed from config should be removed from live as well
+	configDep.Annotations = map[string]string{"bar": "baz"}
+	replicas := int32(3)
+	configDep.Spec.Replicas = &replicas
+	patch, err = LiveTargetPatch(mustToUnstructured(configDep), mustToUnstructured(liveDep))
+	assert.Nil(t, err)
+	assert.Contains(t, string(patch), `"foo":null`)
+	assert.Contains(t, string(patch), `"replicas":3`)
+}
+
 func TestRemoveNamespaceAnnotation(t *testing.T) {
 	obj := removeNamespaceAnnotation(&unstructured.Unstructured{Object: map[string]interface{}{
 		"metadata": map[string]interface{}{
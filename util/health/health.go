@@ -152,6 +152,54 @@ func GetResourceHealth(obj *unstructured.Unstructured, resourceOverrides map[str
 	return health, err
 }
 
+// HealthOverride assesses the health of resources in place of the built-in health checks. It returns nil if the
+// resource is not handled by the override.
+type HealthOverride interface {
+	GetResourceHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error)
+}
+
+// HealthOverrideFunc is an adapter which allows using an ordinary function as a HealthOverride
+type HealthOverrideFunc func(obj *unstructured.Unstructured) (*appv1.HealthStatus, error)
+
+func (f HealthOverrideFunc) GetResourceHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+	return f(obj)
+}
+
+// CompositeHealthOverride tries each override in order and returns the first non-nil result
+type CompositeHealthOverride []HealthOverride
+
+func (c CompositeHealthOverride) GetResourceHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+	for _, override := range c {
+		if override == nil {
+			continue
+		}
+		health, err := override.GetResourceHealth(obj)
+		if err != nil || health != nil {
+			return health, err
+		}
+	}
+	return nil, nil
+}
+
+// GetResourceHealthWithOverride returns the health of a k8s resource assessed by the given override. Resources which
+// are not handled by the override are assessed by GetResourceHealth.
+func GetResourceHealthWithOverride(obj *unstructured.Unstructured, resourceOverrides map[string]appv1.ResourceOverride, override HealthOverride) (*appv1.HealthStatus, error) {
+	if override == nil || obj.GetDeletionTimestamp() != nil {
+		return GetResourceHealth(obj, resourceOverrides)
+	}
+	health, err := override.GetResourceHealth(obj)
+	if err != nil {
+		return &appv1.HealthStatus{
+			Status:  appv1.HealthStatusUnknown,
+			Message: err.Error(),
+		}, err
+	}
+	if health != nil {
+		return health, nil
+	}
+	return GetResourceHealth(obj, resourceOverrides)
+}
+
 // healthOrder is a list of health codes in order of most healthy to least healthy
 var healthOrder = []appv1.HealthStatusCode{
 	appv1.HealthStatusHealthy,
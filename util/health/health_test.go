@@ -1,6 +1,7 @@
 package health
 
 import (
+	"fmt"
 	"io/ioutil"
 	"testing"
 
@@ -236,3 +237,41 @@ func TestGetArgoWorkflowHealth(t *testing.T) {
 func noFilter(obj *unstructured.Unstructured) bool {
 	return true
 }
+
+func TestCompositeHealthOverride(t *testing.T) {
+	yamlBytes, err := ioutil.ReadFile("../kube/testdata/nginx.yaml")
+	assert.Nil(t, err)
+	var obj unstructured.Unstructured
+	err = yaml.Unmarshal(yamlBytes, &obj)
+	assert.Nil(t, err)
+
+	skip := HealthOverrideFunc(func(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+		return nil, nil
+	})
+	degraded := HealthOverrideFunc(func(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+		return &appv1.HealthStatus{Status: appv1.HealthStatusDegraded}, nil
+	})
+	suspended := HealthOverrideFunc(func(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+		return &appv1.HealthStatus{Status: appv1.HealthStatusSuspended}, nil
+	})
+
+	// first non-nil result wins
+	health, err := GetResourceHealthWithOverride(&obj, nil, CompositeHealthOverride{skip, degraded, suspended})
+	assert.Nil(t, err)
+	assert.Equal(t, appv1.HealthStatusDegraded, health.Status)
+
+	health, err = GetResourceHealthWithOverride(&obj, nil, CompositeHealthOverride{nil, suspended, degraded})
+	assert.Nil(t, err)
+	assert.Equal(t, appv1.HealthStatusSuspended, health.Status)
+
+	// built-in health checks are used if no override handles the resource
+	health, err = GetResourceHealthWithOverride(&obj, nil, CompositeHealthOverride{skip})
+	assert.Nil(t, err)
+	assert.Equal(t, appv1.HealthStatusHealthy, health.Status)
+
+	health, err = GetResourceHealthWithOverride(&obj, nil, CompositeHealthOverride{HealthOverrideFunc(func(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+		return nil, fmt.Errorf("failed")
+	}), degraded})
+	assert.Error(t, err)
+	assert.Equal(t, appv1.HealthStatusUnknown, health.Status)
+}
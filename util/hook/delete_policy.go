@@ -1,11 +1,15 @@
 package hook
 
 import (
+	"sort"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	helmhook "github.com/argoproj/argo-cd/util/hook/helm"
+	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/resource"
 )
 
@@ -25,3 +29,32 @@ func DeletePolicies(obj *unstructured.Unstructured) []v1alpha1.HookDeletePolicy
 	}
 	return policies
 }
+
+// HooksToDelete returns keys of the hooks which should be deleted according to their delete policies once the operation
+// has completed with the given phase. Hooks without policies default to BeforeHookCreation, so are not deleted.
+func HooksToDelete(results v1alpha1.ResourceResults, policies map[kube.ResourceKey][]v1alpha1.HookDeletePolicy, opPhase v1alpha1.OperationPhase) []kube.ResourceKey {
+	keys := make([]kube.ResourceKey, 0)
+	if !opPhase.Completed() {
+		return keys
+	}
+	toDelete := make(map[kube.ResourceKey]bool)
+	for _, res := range results {
+		if res.HookType == "" {
+			continue
+		}
+		key := kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+		for _, policy := range policies[key] {
+			if policy == v1alpha1.HookDeletePolicyHookSucceeded && res.HookPhase.Successful() ||
+				policy == v1alpha1.HookDeletePolicyHookFailed && res.HookPhase.Failed() {
+				toDelete[key] = true
+			}
+		}
+	}
+	for key := range toDelete {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return strings.Compare(keys[i].String(), keys[j].String()) < 0
+	})
+	return keys
+}
@@ -7,6 +7,7 @@ import (
 
 	. "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	. "github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/kube"
 )
 
 func TestDeletePolicies(t *testing.T) {
@@ -18,3 +19,24 @@ func TestDeletePolicies(t *testing.T) {
 	// Helm test
 	assert.Equal(t, []HookDeletePolicy{HookDeletePolicyHookSucceeded}, DeletePolicies(Annotate(NewPod(), "helm.sh/hook-delete-policy", "hook-succeeded")))
 }
+
+func TestHooksToDelete(t *testing.T) {
+	results := ResourceResults{
+		{Kind: "Pod", Namespace: "default", Name: "succeeded", HookType: HookTypeSync, HookPhase: OperationSucceeded},
+		{Kind: "Pod", Namespace: "default", Name: "failed", HookType: HookTypeSync, HookPhase: OperationFailed},
+		{Kind: "Pod", Namespace: "default", Name: "before-creation", HookType: HookTypeSync, HookPhase: OperationSucceeded},
+		{Kind: "Pod", Namespace: "default", Name: "not-hook", HookPhase: OperationSucceeded},
+	}
+	policies := map[kube.ResourceKey][]HookDeletePolicy{
+		kube.NewResourceKey("", "Pod", "default", "succeeded"):       {HookDeletePolicyHookSucceeded},
+		kube.NewResourceKey("", "Pod", "default", "failed"):          {HookDeletePolicyHookSucceeded, HookDeletePolicyHookFailed},
+		kube.NewResourceKey("", "Pod", "default", "before-creation"): {HookDeletePolicyBeforeHookCreation},
+		kube.NewResourceKey("", "Pod", "default", "not-hook"):        {HookDeletePolicyHookSucceeded},
+	}
+
+	assert.Equal(t, []kube.ResourceKey{
+		kube.NewResourceKey("", "Pod", "default", "failed"),
+		kube.NewResourceKey("", "Pod", "default", "succeeded"),
+	}, HooksToDelete(results, policies, OperationSucceeded))
+	assert.Empty(t, HooksToDelete(results, policies, OperationRunning))
+}
@@ -52,6 +52,10 @@ type APIResourceInfo struct {
 	GroupKind            schema.GroupKind
 	Meta                 metav1.APIResource
 	GroupVersionResource schema.GroupVersionResource
+	// PreferredVersion is true if the resource is served by the preferred version of its group. Resources which are
+	// not available in the preferred version are served by another version of the group. Resources of groups which
+	// preferred version could not be discovered are considered to be served by the preferred version.
+	PreferredVersion bool
 }
 
 type filterFunc func(apiResource *metav1.APIResource) bool
@@ -69,6 +73,14 @@ func filterAPIResources(config *rest.Config, resourceFilter ResourceFilter, filt
 		}
 		log.Warnf("Partial success when performing preferred resource discovery: %v", err)
 	}
+	preferredVersions := make(map[string]string)
+	if groups, err := disco.ServerGroups(); err == nil {
+		for _, group := range groups.Groups {
+			preferredVersions[group.Name] = group.PreferredVersion.Version
+		}
+	} else {
+		log.Warnf("Failed to discover preferred versions of API groups: %v", err)
+	}
 	apiResIfs := make([]APIResourceInfo, 0)
 	for _, apiResourcesList := range serverResources {
 		gv, err := schema.ParseGroupVersion(apiResourcesList.GroupVersion)
@@ -87,10 +99,12 @@ func filterAPIResources(config *rest.Config, resourceFilter ResourceFilter, filt
 				if err != nil {
 					return nil, err
 				}
+				preferredVersion, known := preferredVersions[gv.Group]
 				apiResIf := APIResourceInfo{
 					GroupKind:            schema.GroupKind{Group: gv.Group, Kind: apiResource.Kind},
 					Meta:                 apiResource,
 					GroupVersionResource: resource,
+					PreferredVersion:     !known || preferredVersion == gv.Version,
 				}
 				apiResIfs = append(apiResIfs, apiResIf)
 			}
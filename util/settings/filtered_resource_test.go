@@ -37,3 +37,16 @@ func TestExcludeResource(t *testing.T) {
 	assert.False(t, FilteredResource{APIGroups: []string{""}, Kinds: []string{"["}, Clusters: []string{""}}.Match("", "", ""))
 	assert.False(t, FilteredResource{APIGroups: []string{""}, Kinds: []string{""}, Clusters: []string{"["}}.Match("", "", ""))
 }
+
+func TestNamespaceResourceExclusion(t *testing.T) {
+	exclusion := NamespaceResourceExclusion{
+		FilteredResource: FilteredResource{APIGroups: []string{""}, Kinds: []string{"Event"}},
+		Namespaces:       []string{"noisy-*"},
+	}
+	assert.True(t, exclusion.Match("", "Event", "https://kubernetes.default.svc", "noisy-ns"))
+	assert.False(t, exclusion.Match("", "Event", "https://kubernetes.default.svc", "default"))
+	assert.False(t, exclusion.Match("", "Pod", "https://kubernetes.default.svc", "noisy-ns"))
+
+	// exclusion without namespaces matches every namespace
+	assert.True(t, NamespaceResourceExclusion{FilteredResource: FilteredResource{Kinds: []string{"Event"}}}.Match("", "Event", "https://kubernetes.default.svc", "default"))
+}
@@ -0,0 +1,158 @@
+package settings
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceCacheSettings holds the options of the cluster resources cache maintained by the application controller. Zero
+// values keep the default behavior.
+type ResourceCacheSettings struct {
+	// SkipNoOpUpdates makes the controller ignore modifications which change neither the spec, labels, annotations and
+	// owner references of the resource nor its computed information, e.g. changes of the managed fields only
+	SkipNoOpUpdates bool `json:"skipNoOpUpdates,omitempty"`
+	// PreferCachedNodes makes the controller build live objects of resources which manifests are not cached from the cached
+	// resource information instead of loading them from the cluster
+	PreferCachedNodes bool `json:"preferCachedNodes,omitempty"`
+	// PreserveNodeInfo makes the full resync reuse the computed information of resources which have not changed since the
+	// previous sync
+	PreserveNodeInfo bool `json:"preserveNodeInfo,omitempty"`
+	// ResyncPeriods holds periods after which resources of the specific kinds are re-listed in addition to the full cluster
+	// resync
+	ResyncPeriods []KindResyncPeriod `json:"resyncPeriods,omitempty"`
+	// TrimObjectMeta makes the controller drop heavy annotations such as kubectl last-applied-configuration from cached
+	// resources. Note that the diff of managed resources then falls back to the two-way diff.
+	TrimObjectMeta bool `json:"trimObjectMeta,omitempty"`
+	// ControllerOwnerRefsOnly makes the controller ignore owner references which are not marked as controller when building
+	// the resources hierarchy
+	ControllerOwnerRefsOnly bool `json:"controllerOwnerRefsOnly,omitempty"`
+	// ListPageSize limits the number of resources retrieved by a single list request. Zero means no limit.
+	ListPageSize int64 `json:"listPageSize,omitempty"`
+	// TrackWatchBytes enables accounting of the estimated watch traffic per kind, which has a CPU cost
+	TrackWatchBytes bool `json:"trackWatchBytes,omitempty"`
+	// LabelSelector limits cached resources to the ones matching the selector. Note that the resources hierarchy might be
+	// incomplete if parents don't match the selector.
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// WatchRetryBackoff makes the retry delay of the failed watches grow exponentially on consecutive failures. Watches
+	// are retried every second if not set.
+	WatchRetryBackoff *WatchRetryBackoff `json:"watchRetryBackoff,omitempty"`
+	// FieldSelectors holds field selectors applied when listing and watching resources of the specific kinds. Kinds
+	// without a selector are cached entirely.
+	FieldSelectors []KindFieldSelector `json:"fieldSelectors,omitempty"`
+	// WatchBookmarks makes watches request bookmark events, which reduces the number of re-lists caused by too old resource
+	// versions. Older API servers ignore it.
+	WatchBookmarks bool `json:"watchBookmarks,omitempty"`
+	// NamespaceWatchConcurrency limits the number of namespace watches being established simultaneously when the cluster
+	// cache is limited to the specific namespaces. Zero means no limit.
+	NamespaceWatchConcurrency int `json:"namespaceWatchConcurrency,omitempty"`
+	// WatchEstablishQPS limits the rate of watch requests, so API servers with aggressive limits of in-flight requests don't
+	// reject watches started at once. Zero means no limit.
+	WatchEstablishQPS float32 `json:"watchEstablishQPS,omitempty"`
+	// WatchEstablishBurst is the maximum number of watch requests sent at once if WatchEstablishQPS is set. Defaults to 1.
+	WatchEstablishBurst int `json:"watchEstablishBurst,omitempty"`
+	// SyncTimeout is the period after which the cluster cache is fully re-synced. Defaults to 24 hours.
+	SyncTimeout metav1.Duration `json:"syncTimeout,omitempty"`
+	// RetryTimeout is the period after which the failed cluster cache sync is retried. Defaults to 10 seconds.
+	RetryTimeout metav1.Duration `json:"retryTimeout,omitempty"`
+	// ListQPS and ListBurst override the client side rate limit of the client used to list resources, so the cluster sync
+	// isn't throttled by the limit of the cluster REST config
+	ListQPS   float32 `json:"listQPS,omitempty"`
+	ListBurst int     `json:"listBurst,omitempty"`
+	// WatchTimeout is the timeout of the client used to watch resources. Watches are restarted once the timeout elapses.
+	WatchTimeout metav1.Duration `json:"watchTimeout,omitempty"`
+	// DebugLocking makes accessing the cluster cache from a handler invoked under the cluster lock panic instead of
+	// deadlocking. The check has a CPU cost, so it is intended for troubleshooting only.
+	DebugLocking bool `json:"debugLocking,omitempty"`
+	// MaxConcurrentLiveQueries limits the number of resources concurrently loaded from the cluster when comparing
+	// applications with resources missing in the cache. Zero means no limit.
+	MaxConcurrentLiveQueries int `json:"maxConcurrentLiveQueries,omitempty"`
+	// MaxObjectSize limits the size in bytes of cached manifests. Manifests of larger resources are loaded from the cluster
+	// when needed. Zero means no limit.
+	MaxObjectSize int `json:"maxObjectSize,omitempty"`
+	// NamespaceExclusions excludes kinds from caching in the specific namespaces, e.g. heavyweight kinds of noisy
+	// namespaces. Exclusions apply only if the cluster cache is limited to the specific namespaces.
+	NamespaceExclusions []NamespaceResourceExclusion `json:"namespaceExclusions,omitempty"`
+	// PriorityKinds holds kinds which are listed and watched before the rest of the kinds during the cluster sync
+	PriorityKinds []CachedKind `json:"priorityKinds,omitempty"`
+	// MaxResources is the soft limit of the number of cached resources of a cluster. Exceeding the limit doesn't prevent
+	// caching, but a warning is logged. Zero means no limit.
+	MaxResources int `json:"maxResources,omitempty"`
+	// SkipEndpointsOwnership disables treating endpoints as children of the service with the same name
+	SkipEndpointsOwnership bool `json:"skipEndpointsOwnership,omitempty"`
+	// SkipServiceAccountTokenOwnership disables treating auto-created service account token secrets as children of the
+	// service account
+	SkipServiceAccountTokenOwnership bool `json:"skipServiceAccountTokenOwnership,omitempty"`
+	// MaxSyncRetries is the number of consecutive sync failures after which the cluster sync is not retried until the
+	// cache is invalidated. Zero means the sync is retried forever.
+	MaxSyncRetries int `json:"maxSyncRetries,omitempty"`
+	// IncludeClusterScopedKinds holds cluster level kinds which are cached even if the cluster is configured with a list
+	// of namespaces
+	IncludeClusterScopedKinds []CachedKind `json:"includeClusterScopedKinds,omitempty"`
+	// LazyResourceInfo defers computing the info, networking info and images of cached resources until they are
+	// accessed
+	LazyResourceInfo bool `json:"lazyResourceInfo,omitempty"`
+	// GroupKindAliases holds kinds which resources are cached under the canonical API group, so the same resource served
+	// by several API groups is cached only once
+	GroupKindAliases []GroupKindAlias `json:"groupKindAliases,omitempty"`
+	// EventCoalesceWindow is the period during which changes of each resource are merged into a single notification of
+	// the controller. Zero means the controller is notified about every change.
+	EventCoalesceWindow metav1.Duration `json:"eventCoalesceWindow,omitempty"`
+}
+
+// KindResyncPeriod holds the period after which resources of the kind are re-listed by the resource cache
+type KindResyncPeriod struct {
+	Group  string          `json:"group,omitempty"`
+	Kind   string          `json:"kind"`
+	Period metav1.Duration `json:"period"`
+}
+
+// WatchRetryBackoff holds the exponential backoff of the failed watches retries
+type WatchRetryBackoff struct {
+	// Duration is the delay of the first retry
+	Duration metav1.Duration `json:"duration"`
+	// Factor multiplies the delay after every consecutive failure
+	Factor float64 `json:"factor,omitempty"`
+	// Jitter randomizes the delay by up to the given fraction of the delay
+	Jitter float64 `json:"jitter,omitempty"`
+	// Cap is the maximum delay of the retry
+	Cap metav1.Duration `json:"cap,omitempty"`
+}
+
+// KindFieldSelector holds the field selector applied when listing and watching resources of the kind
+type KindFieldSelector struct {
+	Group    string `json:"group,omitempty"`
+	Kind     string `json:"kind"`
+	Selector string `json:"selector"`
+}
+
+// NamespaceResourceExclusion excludes resources of the matching kinds and clusters from caching in the matching
+// namespaces. Namespaces are matched using glob patterns; exclusion without namespaces matches every namespace.
+type NamespaceResourceExclusion struct {
+	FilteredResource
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+func (e NamespaceResourceExclusion) matchNamespace(namespace string) bool {
+	for _, excludedNamespace := range e.Namespaces {
+		if match(excludedNamespace, namespace) {
+			return true
+		}
+	}
+	return len(e.Namespaces) == 0
+}
+
+func (e NamespaceResourceExclusion) Match(apiGroup, kind, cluster, namespace string) bool {
+	return e.FilteredResource.Match(apiGroup, kind, cluster) && e.matchNamespace(namespace)
+}
+
+// CachedKind identifies the kind of cached resources
+type CachedKind struct {
+	Group string `json:"group,omitempty"`
+	Kind  string `json:"kind"`
+}
+
+// GroupKindAlias maps the kind served by several API groups to the group under which resources of the kind are cached
+type GroupKindAlias struct {
+	Group          string `json:"group,omitempty"`
+	Kind           string `json:"kind"`
+	CanonicalGroup string `json:"canonicalGroup,omitempty"`
+}
@@ -21,6 +21,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	v1 "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	v1listers "k8s.io/client-go/listers/core/v1"
@@ -197,6 +198,8 @@ const (
 	resourceExclusionsKey = "resource.exclusions"
 	// resourceInclusions is the key to the list of explicitly watched resources
 	resourceInclusionsKey = "resource.inclusions"
+	// resourceCacheKey is the key to the options of the cluster resources cache
+	resourceCacheKey = "resource.cache"
 	// configManagementPluginsKey is the key to the list of config management plugins
 	configManagementPluginsKey = "configManagementPlugins"
 	// kustomizeBuildOptionsKey is a string of kustomize build parameters
@@ -369,6 +372,30 @@ func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.Resource
 	return resourceOverrides, nil
 }
 
+// GetResourceCacheSettings loads the options of the cluster resources cache from argocd-cm ConfigMap
+func (mgr *SettingsManager) GetResourceCacheSettings() (*ResourceCacheSettings, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	cacheSettings := &ResourceCacheSettings{}
+	if value, ok := argoCDCM.Data[resourceCacheKey]; ok {
+		err := yaml.Unmarshal([]byte(value), cacheSettings)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, err := labels.Parse(cacheSettings.LabelSelector); err != nil {
+		return nil, fmt.Errorf("invalid label selector of the resource cache: %v", err)
+	}
+	for _, selector := range cacheSettings.FieldSelectors {
+		if _, err := fields.ParseSelector(selector.Selector); err != nil {
+			return nil, fmt.Errorf("invalid field selector of %s: %v", selector.Kind, err)
+		}
+	}
+	return cacheSettings, nil
+}
+
 // GetKustomizeBuildOptions loads the kustomize build options from argocd-cm ConfigMap
 func (mgr *SettingsManager) GetKustomizeBuildOptions() (string, error) {
 	argoCDCM, err := mgr.getConfigMap()
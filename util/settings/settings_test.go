@@ -3,6 +3,7 @@ package settings
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -144,6 +145,44 @@ func TestGetResourceOverrides(t *testing.T) {
 	}, webHookOverrides)
 }
 
+func TestGetResourceCacheSettings(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"resource.cache": `
+    skipNoOpUpdates: true
+    resyncPeriods:
+    - group: apps
+      kind: ReplicaSet
+      period: 5m`,
+	})
+	cacheSettings, err := settingsManager.GetResourceCacheSettings()
+	assert.NoError(t, err)
+	assert.Equal(t, &ResourceCacheSettings{
+		SkipNoOpUpdates: true,
+		ResyncPeriods:   []KindResyncPeriod{{Group: "apps", Kind: "ReplicaSet", Period: metav1.Duration{Duration: 5 * time.Minute}}},
+	}, cacheSettings)
+
+	_, settingsManager = fixtures(nil)
+	cacheSettings, err = settingsManager.GetResourceCacheSettings()
+	assert.NoError(t, err)
+	assert.Equal(t, &ResourceCacheSettings{}, cacheSettings)
+
+	_, settingsManager = fixtures(map[string]string{
+		"resource.cache": `
+    labelSelector: "app in (guestbook"`,
+	})
+	_, err = settingsManager.GetResourceCacheSettings()
+	assert.Error(t, err)
+
+	_, settingsManager = fixtures(map[string]string{
+		"resource.cache": `
+    fieldSelectors:
+    - kind: Pod
+      selector: "status.phase"`,
+	})
+	_, err = settingsManager.GetResourceCacheSettings()
+	assert.Error(t, err)
+}
+
 func TestSettingsManager_GetKustomizeBuildOptions(t *testing.T) {
 	t.Run("Empty", func(t *testing.T) {
 		_, settingsManager := fixtures(map[string]string{})
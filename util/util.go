@@ -99,6 +99,14 @@ func MakeSignature(size int) ([]byte, error) {
 
 // RetryUntilSucceed keep retrying given action with specified timeout until action succeed or specified context is done.
 func RetryUntilSucceed(action func() error, desc string, ctx context.Context, timeout time.Duration) {
+	RetryUntilSucceedWithDelay(action, desc, ctx, func() time.Duration {
+		return timeout
+	})
+}
+
+// RetryUntilSucceedWithDelay keep retrying given action until action succeed or specified context is done. The delay
+// before every retry is returned by the specified function.
+func RetryUntilSucceedWithDelay(action func() error, desc string, ctx context.Context, delay func() time.Duration) {
 	ctxCompleted := false
 	stop := make(chan bool)
 	defer close(stop)
@@ -120,6 +128,7 @@ func RetryUntilSucceed(action func() error, desc string, ctx context.Context, ti
 			log.Debugf("Stop retrying %s", desc)
 			return
 		}
+		timeout := delay()
 		log.Debugf("Failed to %s: %+v, retrying in %v", desc, err, timeout)
 		time.Sleep(timeout)
 